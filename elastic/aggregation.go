@@ -0,0 +1,100 @@
+package elastic
+
+import (
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/calendarinterval"
+)
+
+// Aggregation wraps a set of named Elasticsearch aggregations, providing methods to build bucket and metric aggregations.
+type Aggregation struct {
+	// aggs holds the underlying Elasticsearch aggregations, keyed by name.
+	aggs map[string]types.Aggregations
+}
+
+// NewAggregation initializes a new Aggregation object, setting up an empty set of aggregations.
+func NewAggregation() *Aggregation {
+	return &Aggregation{
+		aggs: make(map[string]types.Aggregations),
+	}
+}
+
+// Terms adds a Terms aggregation under the given name, bucketing documents by the distinct values of the specified field.
+// The size limits the number of buckets returned; a size of 0 or less uses the Elasticsearch default.
+func (inst *Aggregation) Terms(name string, field string, size int) *Aggregation {
+	terms := &types.TermsAggregation{Field: &field}
+	if size > 0 {
+		terms.Size = &size
+	}
+	inst.aggs[name] = types.Aggregations{Terms: terms}
+	return inst
+}
+
+// DateHistogram adds a DateHistogram aggregation under the given name, bucketing documents by the specified date field.
+// The interval is a calendar interval such as "minute", "hour", "day", "week", "month", "quarter" or "year".
+func (inst *Aggregation) DateHistogram(name string, field string, interval string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{
+		DateHistogram: &types.DateHistogramAggregation{
+			Field:            &field,
+			CalendarInterval: &calendarinterval.CalendarInterval{Name: interval},
+		},
+	}
+	return inst
+}
+
+// Histogram adds a Histogram aggregation under the given name, bucketing documents by the specified numeric field
+// into fixed-size intervals.
+func (inst *Aggregation) Histogram(name string, field string, interval float64) *Aggregation {
+	value := types.Float64(interval)
+	inst.aggs[name] = types.Aggregations{
+		Histogram: &types.HistogramAggregation{Field: &field, Interval: &value},
+	}
+	return inst
+}
+
+// Avg adds an Avg aggregation under the given name, computing the average of the specified numeric field.
+func (inst *Aggregation) Avg(name string, field string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{Avg: &types.AverageAggregation{Field: &field}}
+	return inst
+}
+
+// Sum adds a Sum aggregation under the given name, computing the sum of the specified numeric field.
+func (inst *Aggregation) Sum(name string, field string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{Sum: &types.SumAggregation{Field: &field}}
+	return inst
+}
+
+// Min adds a Min aggregation under the given name, computing the minimum of the specified numeric field.
+func (inst *Aggregation) Min(name string, field string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{Min: &types.MinAggregation{Field: &field}}
+	return inst
+}
+
+// Max adds a Max aggregation under the given name, computing the maximum of the specified numeric field.
+func (inst *Aggregation) Max(name string, field string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{Max: &types.MaxAggregation{Field: &field}}
+	return inst
+}
+
+// Cardinality adds a Cardinality aggregation under the given name, approximating the number of distinct values of the specified field.
+func (inst *Aggregation) Cardinality(name string, field string) *Aggregation {
+	inst.aggs[name] = types.Aggregations{Cardinality: &types.CardinalityAggregation{Field: &field}}
+	return inst
+}
+
+// SubAggregation nests the given aggregations under the previously added aggregation with the specified name,
+// computing them within each of its buckets. It has no effect if no aggregation with that name exists.
+func (inst *Aggregation) SubAggregation(name string, sub *Aggregation) *Aggregation {
+	parent, ok := inst.aggs[name]
+	if !ok {
+		return inst
+	}
+
+	if parent.Aggregations == nil {
+		parent.Aggregations = make(map[string]types.Aggregations, len(sub.aggs))
+	}
+	for subName, agg := range sub.aggs {
+		parent.Aggregations[subName] = agg
+	}
+	inst.aggs[name] = parent
+	return inst
+}
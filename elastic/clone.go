@@ -0,0 +1,70 @@
+package elastic
+
+import (
+	"reflect"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// Clone returns a deep copy of the Query, so it can be extended without affecting the original.
+// This allows several queries to be built from a common base query.
+func (inst *Query) Clone() *Query {
+	return &Query{q: deepCopy(reflect.ValueOf(inst.q)).Interface().(*types.Query)}
+}
+
+// deepCopy is a helper function to recursively copy pointers, maps, slices, interfaces and structs,
+// preserving the dynamic types so the builder methods keep working on the copy.
+func deepCopy(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Elem().Type())
+		copied.Elem().Set(deepCopy(value.Elem()))
+		return copied
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(deepCopy(value.Elem()))
+		return copied
+
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return copied
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(deepCopy(value.Index(i)))
+		}
+		return copied
+
+	case reflect.Struct:
+		// Start from a shallow copy so unexported fields are kept, then deep copy the exported ones
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(deepCopy(value.Field(i)))
+			}
+		}
+		return copied
+
+	default:
+		return value
+	}
+}
@@ -1,5 +1,10 @@
 package elastic
 
+import (
+	"github.com/nguyendang2000/shared-go/metrics"
+	"go.opentelemetry.io/otel/trace"
+)
+
 // Config represents the configuration settings for connecting to an Elasticsearch cluster.
 // The structure includes fields for connection addresses, authentication, certificates, and timeout settings.
 type Config struct {
@@ -23,7 +28,38 @@ type Config struct {
 	// This can be used to establish a secure connection with self-signed certificates.
 	CACert string `yaml:"ca_cert"`
 
-	// Timeout specifies the maximum time (in milliseconds) to wait for a connection.
+	// Timeout specifies the maximum time (in milliseconds) to wait for each Elasticsearch operation.
 	// This field is optional, and if not set, the default timeout is used.
 	Timeout int64 `yaml:"timeout"`
+
+	// RefreshOnWrite makes write operations wait for the affected shards to refresh (refresh=wait_for)
+	// before returning, so subsequent reads see the changes immediately.
+	// This field is optional and intended mainly for tests, as it slows down writes.
+	RefreshOnWrite bool `yaml:"refresh_on_write"`
+
+	// VerifyConnection makes NewService ping the cluster and fail fast if it is unreachable.
+	// This field is optional; by default the connection is only established on the first request.
+	VerifyConnection bool `yaml:"verify_connection"`
+
+	// ConnectAttempts sets how many times the VerifyConnection ping is attempted before NewService returns an error,
+	// so a service can start before the cluster is ready. This field is optional and defaults to a single attempt.
+	ConnectAttempts int `yaml:"connect_attempts"`
+
+	// ConnectBackoff sets the delay (in milliseconds) before retrying the VerifyConnection ping.
+	// The delay doubles after each failed attempt. This field is optional and defaults to DefaultConnectBackoff.
+	ConnectBackoff int64 `yaml:"connect_backoff"`
+
+	// MetricsCollector optionally records the latency and outcome of every API call.
+	// This field is optional; metrics are disabled if it is nil.
+	MetricsCollector metrics.Collector `yaml:"-"`
+
+	// TracerProvider optionally traces every API call in its own span, using the standard Elasticsearch client
+	// instrumentation. The Service methods do not take a context, so the spans are roots and do not join the caller's trace.
+	// This field is optional; tracing is disabled if it is nil.
+	TracerProvider trace.TracerProvider `yaml:"-"`
+
+	// Serializer optionally replaces encoding/json for encoding indexed documents and decoding retrieved ones,
+	// e.g. with a faster JSON library. Queries and other requests are always encoded with encoding/json.
+	// This field is optional; encoding/json is used if it is nil.
+	Serializer Serializer `yaml:"-"`
 }
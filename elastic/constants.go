@@ -2,3 +2,12 @@ package elastic
 
 // DefaultTimeout defines the default timeout for connections, specified in milliseconds.
 const DefaultTimeout int64 = 3000 // default in milliseconds
+
+// DefaultConnectBackoff defines the default delay before retrying to reach the cluster in NewService, specified in milliseconds.
+const DefaultConnectBackoff int64 = 1000 // default in milliseconds
+
+// DefaultRetryOnConflict defines how many times a partial update is retried when a version conflict occurs.
+const DefaultRetryOnConflict = 3
+
+// DefaultWaitInterval defines the interval between lookups in WaitForDocument, specified in milliseconds.
+const DefaultWaitInterval int64 = 100 // default in milliseconds
@@ -1,21 +1,21 @@
 package elastic
 
 import (
-	"context"
 	"fmt"
-	"time"
+	"strings"
 
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/result"
 )
 
 // DeleteByID deletes a document by its unique ID from the specified index.
 // Returns an error if the document could not be deleted.
 func (inst *Service) DeleteByID(index string, id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
 	// Execute delete request by document ID
-	response, err := inst.client.Delete(index, id).Do(ctx)
+	response, err := inst.client.Delete(index, id).Refresh(inst.refreshPolicy()).Do(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %s", ErrDeletingDocument, err)
 	}
@@ -28,14 +28,55 @@ func (inst *Service) DeleteByID(index string, id string) error {
 	return nil
 }
 
+// DeleteMany deletes multiple documents by their unique IDs from the specified index using a single bulk request.
+// IDs that do not exist are ignored. Returns an error if any of the deletions fail.
+func (inst *Service) DeleteMany(index string, ids []string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Start a bulk request for multiple documents
+	bulkRequest := inst.client.Bulk().Index(index).Refresh(inst.refreshPolicy())
+
+	// Add a delete operation for each document ID
+	for _, id := range ids {
+		docID := id
+		if err := bulkRequest.DeleteOp(types.DeleteOperation{Id_: &docID}); err != nil {
+			return fmt.Errorf("%w: %s", ErrDeletingDocuments, err)
+		}
+	}
+
+	// Execute the bulk delete request
+	response, err := bulkRequest.Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDeletingDocuments, err)
+	}
+
+	// Aggregate any errors in the bulk response items
+	var bulkErrors []string
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Error != nil {
+				bulkErrors = append(bulkErrors, fmt.Sprintf("document ID %s: %v", *result.Id_, result.Error))
+			}
+		}
+	}
+
+	// If there were any bulk errors, return a combined error message
+	if len(bulkErrors) > 0 {
+		return fmt.Errorf("%w: %s", ErrDeletingDocuments, strings.Join(bulkErrors, "; "))
+	}
+
+	return nil
+}
+
 // Delete deletes all documents in the specified index that match the provided query.
 // Returns an error if the delete-by-query operation encounters issues.
 func (inst *Service) Delete(index string, query *Query) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
 	// Execute the delete-by-query request
-	response, err := inst.client.DeleteByQuery(index).Query(query.q).Do(ctx)
+	response, err := inst.client.DeleteByQuery(index).Query(query.q).Refresh(inst.refreshOnWrite).Do(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %s", ErrDeletingDocuments, err)
 	}
@@ -9,3 +9,47 @@ type Document interface {
 	// SetID sets the document's ID.
 	SetID(id string)
 }
+
+// BaseDocument provides a default implementation of the Document interface, to be embedded in document structs:
+//
+//	type Article struct {
+//		elastic.BaseDocument
+//		Title string `json:"title"`
+//	}
+//
+// The ID is carried as the Elasticsearch _id metadata rather than in the document source, so it is excluded from JSON,
+// as Elasticsearch rejects _id fields inside the source. It maps to _id in BSON, so the same struct can be stored in MongoDB.
+type BaseDocument struct {
+	// ID is the unique ID of the document.
+	ID string `json:"-" bson:"_id,omitempty"`
+}
+
+// GetID retrieves the document's ID.
+func (inst *BaseDocument) GetID() string {
+	return inst.ID
+}
+
+// SetID sets the document's ID.
+func (inst *BaseDocument) SetID(id string) {
+	inst.ID = id
+}
+
+// Highlightable represents an optional interface for documents that can receive highlighted snippets from a search.
+// Documents implementing it have their highlights populated when SearchOptions.HighlightFields is set.
+type Highlightable interface {
+	// SetHighlights sets the highlighted snippets, keyed by field name.
+	SetHighlights(highlights map[string][]string)
+}
+
+// Scorable represents an optional interface for documents that can receive their relevance score from a search.
+type Scorable interface {
+	// SetScore sets the relevance score of the document for the query.
+	SetScore(score float64)
+}
+
+// Fieldable represents an optional interface for documents that can receive the values of script and runtime fields
+// requested through SearchOptions.ScriptFields and SearchOptions.RuntimeFields.
+type Fieldable interface {
+	// SetFields sets the computed field values, keyed by field name. Elasticsearch returns each value as an array.
+	SetFields(fields map[string][]interface{})
+}
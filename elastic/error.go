@@ -8,10 +8,22 @@ var (
 	ErrNoAddresses = errors.New("no addresses provided in the configuration")
 	// ErrOpeningCACert is returned when there is an error opening the CA certificate file.
 	ErrOpeningCACert = errors.New("error opening CA certificate file")
+	// ErrParsingCACert is returned when the CA certificate file does not contain a valid PEM certificate.
+	ErrParsingCACert = errors.New("error parsing CA certificate")
+	// ErrInvalidFingerprint is returned when the certificate fingerprint is not a valid hex string.
+	ErrInvalidFingerprint = errors.New("invalid certificate fingerprint")
 	// ErrCreatingElasticClient is returned when there is an error creating the Elasticsearch client.
 	ErrCreatingElasticClient = errors.New("error creating Elasticsearch client")
 )
 
+// Connection Errors
+var (
+	// ErrPingingElastic is returned when the Elasticsearch cluster cannot be reached.
+	ErrPingingElastic = errors.New("failed to ping Elasticsearch")
+	// ErrFingerprintMismatch is returned when no certificate presented by the server matches the configured fingerprint.
+	ErrFingerprintMismatch = errors.New("certificate fingerprint mismatch")
+)
+
 // Indexing Errors
 var (
 	// ErrIndexingDocument is returned when indexing a document fails.
@@ -38,6 +50,16 @@ var (
 	ErrUnmarshalingDocument = errors.New("failed to unmarshal document into result")
 	// ErrUnmarshalingDocuments is returned when unmarshaling multiple documents fails.
 	ErrUnmarshalingDocuments = errors.New("failed to unmarshal documents")
+	// ErrWaitingForDocument is returned when a document does not become available before the wait timeout.
+	ErrWaitingForDocument = errors.New("timed out waiting for document")
+)
+
+// Document Update Errors
+var (
+	// ErrUpdatingDocument is returned when partially updating a document fails.
+	ErrUpdatingDocument = errors.New("failed to update document")
+	// ErrUpdatingDocuments is returned when updating documents by query fails.
+	ErrUpdatingDocuments = errors.New("failed to update documents by query")
 )
 
 // Document Deletion Errors
@@ -56,10 +78,32 @@ var (
 	ErrSearchingDocuments = errors.New("failed to execute search query")
 	// ErrDecodingSearchResponse is returned when decoding a search response into the result fails.
 	ErrDecodingSearchResponse = errors.New("failed to decode search response into result")
+	// ErrAggregatingDocuments is returned when an aggregation query fails to execute.
+	ErrAggregatingDocuments = errors.New("failed to execute aggregation query")
 	// ErrCountingDocuments is returned when counting documents fails.
 	ErrCountingDocuments = errors.New("failed to count documents")
 	// ErrCheckingDocumentExists is returned when checking if a document exists fails.
 	ErrCheckingDocumentExists = errors.New("failed to check if document exists")
+	// ErrParsingQuery is returned when raw query JSON cannot be parsed into a query.
+	ErrParsingQuery = errors.New("failed to parse query")
+)
+
+// Index Management Errors
+var (
+	// ErrRefreshingIndex is returned when refreshing an index fails.
+	ErrRefreshingIndex = errors.New("failed to refresh index")
+	// ErrCreatingIndex is returned when creating an index fails.
+	ErrCreatingIndex = errors.New("failed to create index")
+	// ErrDeletingIndex is returned when deleting an index fails.
+	ErrDeletingIndex = errors.New("failed to delete index")
+	// ErrCheckingIndexExists is returned when checking if an index exists fails.
+	ErrCheckingIndexExists = errors.New("failed to check if index exists")
+	// ErrUpdatingAliases is returned when creating, deleting or swapping an alias fails.
+	ErrUpdatingAliases = errors.New("failed to update aliases")
+	// ErrReindexing is returned when copying documents to another index fails.
+	ErrReindexing = errors.New("failed to reindex documents")
+	// ErrMissingReindexTask is returned when a background reindex response has no task ID.
+	ErrMissingReindexTask = errors.New("reindex response has no task ID")
 )
 
 // General Errors
@@ -1,10 +1,10 @@
 package elastic
 
 import (
-	"context"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 )
@@ -12,11 +12,17 @@ import (
 // IndexOne indexes or updates a single document in the specified index.
 // The document must implement the Document interface, which provides a unique ID.
 func (inst *Service) IndexOne(index string, doc Document) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
+	// Encode the document with the configured serializer
+	data, err := inst.serializer.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrMarshalingDocument, err)
+	}
+
 	// Attempt to index the document with the specified ID
-	_, err := inst.client.Index(index).Id(doc.GetID()).Request(doc).Do(ctx)
+	_, err = inst.client.Index(index).Id(doc.GetID()).Raw(bytes.NewReader(data)).Refresh(inst.refreshPolicy()).Do(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %s", ErrIndexingDocument, err)
 	}
@@ -27,20 +33,27 @@ func (inst *Service) IndexOne(index string, doc Document) error {
 // Index indexes multiple documents in the specified index.
 // Each document must implement the Document interface, which provides a unique ID for each document.
 func (inst *Service) Index(index string, docs []Document) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
 	// Start a bulk request for multiple documents
-	bulkRequest := inst.client.Bulk().Index(index)
+	bulkRequest := inst.client.Bulk().Index(index).Refresh(inst.refreshPolicy())
 
 	// Add each document to the bulk request with its custom ID
 	for _, doc := range docs {
+		data, err := inst.serializer.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrMarshalingDocuments, err)
+		}
+
 		id := new(string)
 		*id = doc.GetID()
-		bulkRequest.IndexOp(types.IndexOperation{
+		if err := bulkRequest.IndexOp(types.IndexOperation{
 			DynamicTemplates: make(map[string]string),
 			Id_:              id,
-		}, doc)
+		}, json.RawMessage(data)); err != nil {
+			return fmt.Errorf("%w: %s", ErrIndexingDocuments, err)
+		}
 	}
 
 	// Execute the bulk indexing request
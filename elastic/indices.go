@@ -0,0 +1,209 @@
+package elastic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/reindex"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/updatealiases"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// Refresh refreshes the specified index, making all operations performed since the last refresh visible to search.
+// This is mainly useful in tests; Elasticsearch refreshes indices periodically on its own.
+func (inst *Service) Refresh(index string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the refresh request
+	_, err := inst.client.Indices.Refresh().Index(index).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrRefreshingIndex, err)
+	}
+
+	return nil
+}
+
+// WaitForDocument polls the specified index every DefaultWaitInterval until the document with the given ID exists,
+// then refreshes the index so the document is also visible to searches.
+// This is intended for tests relying on near real-time indexing and should not be used in the hot path.
+// Returns an error wrapping ErrWaitingForDocument if the document is not found within the timeout.
+func (inst *Service) WaitForDocument(index string, id string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		// Look the document up, retrying only while it is not found
+		_, err := inst.get(index, id)
+		if err == nil {
+			return inst.Refresh(index)
+		}
+		if !errors.Is(err, ErrDocumentNotFound) {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w with ID %s in index %s after %s", ErrWaitingForDocument, id, index, timeout)
+		}
+		time.Sleep(time.Duration(DefaultWaitInterval) * time.Millisecond)
+	}
+}
+
+// CreateIndex creates the specified index with explicit mappings and settings, either of which may be nil.
+// The mappings are given in the Elasticsearch format, e.g. {"properties": {"title": {"type": "text"}}},
+// and the settings likewise, e.g. {"number_of_shards": 1}.
+func (inst *Service) CreateIndex(index string, mappings, settings map[string]interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Prepare the request body with the provided mappings and settings
+	body := map[string]interface{}{}
+	if mappings != nil {
+		body["mappings"] = mappings
+	}
+	if settings != nil {
+		body["settings"] = settings
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCreatingIndex, err)
+	}
+
+	// Execute the create index request
+	_, err = inst.client.Indices.Create(index).Raw(bytes.NewReader(data)).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCreatingIndex, err)
+	}
+
+	return nil
+}
+
+// DeleteIndex deletes the specified index along with all its documents.
+func (inst *Service) DeleteIndex(index string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the delete index request
+	_, err := inst.client.Indices.Delete(index).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDeletingIndex, err)
+	}
+
+	return nil
+}
+
+// IndexExists checks whether the specified index exists.
+func (inst *Service) IndexExists(index string) (bool, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the index exists request
+	exists, err := inst.client.Indices.Exists(index).Do(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", ErrCheckingIndexExists, err)
+	}
+
+	return exists, nil
+}
+
+// CreateAlias points the alias at the specified index, in addition to any index it already points to.
+func (inst *Service) CreateAlias(index string, alias string) error {
+	return inst.updateAliases(types.IndicesAction{
+		Add: &types.AddAction{Index: &index, Alias: &alias},
+	})
+}
+
+// DeleteAlias removes the alias from the specified index.
+func (inst *Service) DeleteAlias(index string, alias string) error {
+	return inst.updateAliases(types.IndicesAction{
+		Remove: &types.RemoveAction{Index: &index, Alias: &alias},
+	})
+}
+
+// SwapAlias atomically moves the alias from oldIndex to newIndex, so readers using the alias never see
+// a missing or doubled index. This is the final step of a zero-downtime reindex.
+func (inst *Service) SwapAlias(alias string, oldIndex string, newIndex string) error {
+	return inst.updateAliases(
+		types.IndicesAction{Remove: &types.RemoveAction{Index: &oldIndex, Alias: &alias}},
+		types.IndicesAction{Add: &types.AddAction{Index: &newIndex, Alias: &alias}},
+	)
+}
+
+// updateAliases is a helper function to apply alias actions in a single atomic _aliases request.
+func (inst *Service) updateAliases(actions ...types.IndicesAction) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the update aliases request
+	_, err := inst.client.Indices.UpdateAliases().Request(&updatealiases.Request{Actions: actions}).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUpdatingAliases, err)
+	}
+
+	return nil
+}
+
+// Reindex copies the documents of sourceIndex matching the query into destIndex and waits for completion.
+// The whole copy must finish within the Service timeout; use ReindexWithOptions with Async for large indices.
+func (inst *Service) Reindex(sourceIndex string, destIndex string, query *Query) error {
+	_, err := inst.ReindexWithOptions(sourceIndex, destIndex, query, nil)
+	return err
+}
+
+// ReindexWithOptions copies the documents of sourceIndex matching the query into destIndex, applying the given options
+// such as slicing. When opts.Async is set, it returns the ID of the background task instead of waiting for completion;
+// otherwise it returns an empty task ID once the copy is done.
+func (inst *Service) ReindexWithOptions(sourceIndex string, destIndex string, query *Query, opts *ReindexOptions) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Prepare the reindex request from the source index and query to the destination index
+	request := inst.client.Reindex().Request(&reindex.Request{
+		Source: types.ReindexSource{Index: []string{sourceIndex}, Query: query.q},
+		Dest:   types.ReindexDestination{Index: destIndex},
+	}).Refresh(inst.refreshOnWrite)
+
+	// Optional: Split the copy into slices and run it in the background
+	if opts != nil {
+		if opts.Slices < 0 {
+			request.Slices("auto")
+		} else if opts.Slices > 0 {
+			request.Slices(strconv.Itoa(opts.Slices))
+		}
+		if opts.Async {
+			request.WaitForCompletion(false)
+		}
+	}
+
+	// Execute the reindex request
+	response, err := request.Do(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrReindexing, err)
+	}
+
+	// Return the task ID of a background reindex
+	if opts != nil && opts.Async {
+		if response.Task == nil {
+			return "", ErrMissingReindexTask
+		}
+		return fmt.Sprint(response.Task), nil
+	}
+
+	// Aggregate any failures in the reindex response
+	if len(response.Failures) > 0 {
+		failures := make([]string, len(response.Failures))
+		for i, failure := range response.Failures {
+			reason := failure.Cause.Type
+			if failure.Cause.Reason != nil {
+				reason = *failure.Cause.Reason
+			}
+			failures[i] = fmt.Sprintf("document ID %s: %s", failure.Id, reason)
+		}
+		return "", fmt.Errorf("%w: %s", ErrReindexing, strings.Join(failures, "; "))
+	}
+
+	return "", nil
+}
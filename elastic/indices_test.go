@@ -0,0 +1,47 @@
+package elastic
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestService returns a Service sending its requests to a test server answering every request with the given body.
+func newTestService(t *testing.T, body string) *Service {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := NewService(Config{Addresses: []string{server.URL}})
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	return service
+}
+
+func TestReindexWithOptionsReturnsTaskID(t *testing.T) {
+	service := newTestService(t, `{"task":"node:42"}`)
+
+	task, err := service.ReindexWithOptions("source", "dest", NewQuery().MatchAll(), &ReindexOptions{Async: true})
+	if err != nil {
+		t.Fatalf("reindex: %v", err)
+	}
+	if task != "node:42" {
+		t.Fatalf("got task ID %q, want %q", task, "node:42")
+	}
+}
+
+func TestReindexWithOptionsRejectsMissingTaskID(t *testing.T) {
+	service := newTestService(t, `{}`)
+
+	task, err := service.ReindexWithOptions("source", "dest", NewQuery().MatchAll(), &ReindexOptions{Async: true})
+	if !errors.Is(err, ErrMissingReindexTask) {
+		t.Fatalf("got task ID %q and error %v, want %v", task, err, ErrMissingReindexTask)
+	}
+}
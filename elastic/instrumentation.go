@@ -0,0 +1,73 @@
+package elastic
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+)
+
+// instrumentations combines several elastictransport.Instrumentation implementations,
+// so metrics and tracing can be enabled together.
+type instrumentations []elastictransport.Instrumentation
+
+// Start starts each instrumentation in turn, threading the context through them.
+func (inst instrumentations) Start(ctx context.Context, name string) context.Context {
+	for _, i := range inst {
+		ctx = i.Start(ctx, name)
+	}
+	return ctx
+}
+
+// Close closes each instrumentation in reverse order.
+func (inst instrumentations) Close(ctx context.Context) {
+	for idx := len(inst) - 1; idx >= 0; idx-- {
+		inst[idx].Close(ctx)
+	}
+}
+
+// RecordError propagates the error to each instrumentation.
+func (inst instrumentations) RecordError(ctx context.Context, err error) {
+	for _, i := range inst {
+		i.RecordError(ctx, err)
+	}
+}
+
+// RecordPathPart propagates the path variable to each instrumentation.
+func (inst instrumentations) RecordPathPart(ctx context.Context, pathPart, value string) {
+	for _, i := range inst {
+		i.RecordPathPart(ctx, pathPart, value)
+	}
+}
+
+// RecordRequestBody returns the first replacement body provided by an instrumentation, if any.
+func (inst instrumentations) RecordRequestBody(ctx context.Context, endpoint string, query io.Reader) io.ReadCloser {
+	for _, i := range inst {
+		if body := i.RecordRequestBody(ctx, endpoint, query); body != nil {
+			return body
+		}
+	}
+	return nil
+}
+
+// BeforeRequest propagates the request to each instrumentation.
+func (inst instrumentations) BeforeRequest(req *http.Request, endpoint string) {
+	for _, i := range inst {
+		i.BeforeRequest(req, endpoint)
+	}
+}
+
+// AfterRequest propagates the request to each instrumentation.
+func (inst instrumentations) AfterRequest(req *http.Request, system, endpoint string) {
+	for _, i := range inst {
+		i.AfterRequest(req, system, endpoint)
+	}
+}
+
+// AfterResponse propagates the response to each instrumentation.
+func (inst instrumentations) AfterResponse(ctx context.Context, res *http.Response) {
+	for _, i := range inst {
+		i.AfterResponse(ctx, res)
+	}
+}
@@ -0,0 +1,62 @@
+package elastic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/nguyendang2000/shared-go/metrics"
+)
+
+// operationKey is the context key under which the in-flight operation is stored.
+type operationKey struct{}
+
+// operation tracks a single API call between the Start and Close instrumentation callbacks.
+type operation struct {
+	name  string
+	start time.Time
+	err   error
+}
+
+// metricsInstrumentation is an elastictransport.Instrumentation recording each API call,
+// named after the endpoint (e.g. "search", "index"), with a metrics.Collector.
+type metricsInstrumentation struct {
+	collector metrics.Collector
+}
+
+// Start begins tracking the API call.
+func (inst metricsInstrumentation) Start(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, &operation{name: name, start: time.Now()})
+}
+
+// Close records the API call once the client has returned.
+func (inst metricsInstrumentation) Close(ctx context.Context) {
+	if op, ok := ctx.Value(operationKey{}).(*operation); ok {
+		inst.collector.Observe(metrics.SystemElastic, op.name, time.Since(op.start), op.err)
+	}
+}
+
+// RecordError keeps the error the API call failed with.
+func (inst metricsInstrumentation) RecordError(ctx context.Context, err error) {
+	if op, ok := ctx.Value(operationKey{}).(*operation); ok {
+		op.err = err
+	}
+}
+
+// RecordPathPart is a no-op.
+func (inst metricsInstrumentation) RecordPathPart(context.Context, string, string) {}
+
+// RecordRequestBody leaves the request body unchanged.
+func (inst metricsInstrumentation) RecordRequestBody(context.Context, string, io.Reader) io.ReadCloser {
+	return nil
+}
+
+// BeforeRequest is a no-op.
+func (inst metricsInstrumentation) BeforeRequest(*http.Request, string) {}
+
+// AfterRequest is a no-op.
+func (inst metricsInstrumentation) AfterRequest(*http.Request, string, string) {}
+
+// AfterResponse is a no-op.
+func (inst metricsInstrumentation) AfterResponse(context.Context, *http.Response) {}
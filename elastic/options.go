@@ -0,0 +1,181 @@
+package elastic
+
+import (
+	"encoding/json"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/runtimefieldtype"
+)
+
+// SearchOptions represents optional settings for a search request.
+// All fields are optional; a nil or zero-valued SearchOptions performs a plain search.
+type SearchOptions struct {
+	// Includes lists the _source fields to return. Wildcards such as "user.*" are supported.
+	// If empty, all fields are returned unless excluded.
+	Includes []string
+
+	// Excludes lists the _source fields to omit from the returned documents.
+	Excludes []string
+
+	// HighlightFields lists the fields for which highlighted snippets should be returned.
+	// Snippets are passed to documents implementing the Highlightable interface.
+	HighlightFields []string
+
+	// HighlightPreTags and HighlightPostTags define the tags wrapped around highlighted terms.
+	// If empty, Elasticsearch defaults to <em> and </em>.
+	HighlightPreTags  []string
+	HighlightPostTags []string
+
+	// TrackTotalHits counts all matching documents exactly instead of stopping at 10,000,
+	// at the cost of a slower search. It is reflected in SearchResult.TotalHits.
+	TrackTotalHits bool
+
+	// ScriptFields computes additional values per hit with Painless scripts, keyed by field name.
+	// For example, the distance in meters to a point from a geo_point field "location":
+	//
+	//	ScriptFields: map[string]Script{
+	//		"distance": {
+	//			Source: "doc['location'].arcDistance(params.lat, params.lon)",
+	//			Params: map[string]interface{}{"lat": 52.37, "lon": 4.89},
+	//		},
+	//	}
+	//
+	// The computed values are passed to documents implementing the Fieldable interface, alongside _source.
+	ScriptFields map[string]Script
+
+	// RuntimeFields defines fields computed at query time, keyed by field name. Unlike script fields,
+	// runtime fields can also be used in the query, sorting and aggregations. Their values are returned
+	// to documents implementing the Fieldable interface.
+	RuntimeFields map[string]RuntimeField
+}
+
+// Script represents a Painless script with optional parameters.
+type Script struct {
+	// Source is the script source code.
+	Source string
+
+	// Params holds the values available to the script as params.<name>.
+	Params map[string]interface{}
+}
+
+// RuntimeField represents a field computed at query time by a script.
+type RuntimeField struct {
+	// Type is the field type, e.g. "keyword", "long", "double", "date", "boolean" or "geo_point".
+	Type string
+
+	// Script computes the field value by calling emit(value).
+	Script Script
+}
+
+// toTypes is a helper function to convert the script to its Elasticsearch representation.
+func (inst Script) toTypes() (types.Script, error) {
+	script := types.Script{Source: &inst.Source}
+	if len(inst.Params) > 0 {
+		script.Params = make(map[string]json.RawMessage, len(inst.Params))
+		for name, value := range inst.Params {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return types.Script{}, err
+			}
+			script.Params[name] = data
+		}
+	}
+	return script, nil
+}
+
+// apply is a helper function to set the options on the given search request.
+// It returns an error if a script parameter cannot be encoded.
+func (inst *SearchOptions) apply(request *search.Search) error {
+	if inst == nil {
+		return nil
+	}
+
+	// Limit the returned _source fields if requested
+	if len(inst.Includes) > 0 || len(inst.Excludes) > 0 {
+		request.Source_(&types.SourceFilter{
+			Includes: inst.Includes,
+			Excludes: inst.Excludes,
+		})
+	}
+
+	// Count all matching documents exactly if requested
+	if inst.TrackTotalHits {
+		request.TrackTotalHits(true)
+	}
+
+	// Request highlighted snippets for the given fields
+	if len(inst.HighlightFields) > 0 {
+		fields := make(map[string]types.HighlightField, len(inst.HighlightFields))
+		for _, field := range inst.HighlightFields {
+			fields[field] = types.HighlightField{}
+		}
+		request.Highlight(&types.Highlight{
+			Fields:   fields,
+			PreTags:  inst.HighlightPreTags,
+			PostTags: inst.HighlightPostTags,
+		})
+	}
+
+	// Compute script fields, keeping the _source that Elasticsearch would otherwise omit
+	if len(inst.ScriptFields) > 0 {
+		scriptFields := make(map[string]types.ScriptField, len(inst.ScriptFields))
+		for name, script := range inst.ScriptFields {
+			converted, err := script.toTypes()
+			if err != nil {
+				return err
+			}
+			scriptFields[name] = types.ScriptField{Script: converted}
+		}
+		request.ScriptFields(scriptFields)
+		if len(inst.Includes) == 0 && len(inst.Excludes) == 0 {
+			request.Source_(true)
+		}
+	}
+
+	// Define runtime fields and request their values
+	if len(inst.RuntimeFields) > 0 {
+		runtimeFields := make(types.RuntimeFields, len(inst.RuntimeFields))
+		fields := make([]types.FieldAndFormat, 0, len(inst.RuntimeFields))
+		for name, field := range inst.RuntimeFields {
+			converted, err := field.Script.toTypes()
+			if err != nil {
+				return err
+			}
+			runtimeFields[name] = types.RuntimeField{
+				Type:   runtimefieldtype.RuntimeFieldType{Name: field.Type},
+				Script: &converted,
+			}
+			fields = append(fields, types.FieldAndFormat{Field: name})
+		}
+		request.RuntimeMappings(runtimeFields).Fields(fields...)
+	}
+
+	return nil
+}
+
+// TextQueryOptions represents optional settings for full-text queries such as MultiMatch and QueryString.
+// All fields are optional; empty values use the Elasticsearch defaults.
+type TextQueryOptions struct {
+	// Type selects how the query is executed across fields, e.g. "best_fields", "most_fields",
+	// "cross_fields", "phrase", "phrase_prefix" or "bool_prefix".
+	Type string
+
+	// Analyzer overrides the analyzer used to convert the query text into tokens.
+	Analyzer string
+
+	// Operator sets the boolean logic used to combine terms: "or" (default) or "and".
+	Operator string
+}
+
+// ReindexOptions represents optional settings for ReindexWithOptions.
+// All fields are optional; a nil or zero-valued ReindexOptions copies the documents in a single slice and waits for completion.
+type ReindexOptions struct {
+	// Slices splits the copy into the given number of parallel slices, which speeds up large indices.
+	// A negative value lets Elasticsearch choose the number of slices ("auto").
+	Slices int
+
+	// Async starts the reindex as a background task and returns its task ID immediately instead of waiting for completion.
+	// The task can be monitored through the tasks API.
+	Async bool
+}
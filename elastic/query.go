@@ -1,6 +1,13 @@
 package elastic
 
-import "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/operator"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/textquerytype"
+)
 
 // Query wraps an Elasticsearch query object, providing methods to build complex queries.
 type Query struct {
@@ -15,6 +22,33 @@ func NewQuery() *Query {
 	}
 }
 
+// NewRawQuery initializes a new Query object from raw query JSON, e.g. `{"match_phrase": {"title": "quick fox"}}`.
+// This is an escape hatch for queries the builder does not cover. Returns an error if the JSON is not a valid query.
+func NewRawQuery(raw string) (*Query, error) {
+	q := &types.Query{}
+	if err := json.Unmarshal([]byte(raw), q); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParsingQuery, err)
+	}
+
+	// Reject queries whose keys were all unknown, since they would silently match every document
+	if data, err := json.Marshal(q); err != nil || string(data) == "{}" {
+		return nil, fmt.Errorf("%w: no known query type in %s", ErrParsingQuery, raw)
+	}
+
+	return &Query{q: q}, nil
+}
+
+// NewQueryFromMap initializes a new Query object from a query expressed as a map,
+// e.g. map[string]interface{}{"match_phrase": map[string]interface{}{"title": "quick fox"}}.
+// Returns an error if the map does not describe a valid query.
+func NewQueryFromMap(m map[string]interface{}) (*Query, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParsingQuery, err)
+	}
+	return NewRawQuery(string(data))
+}
+
 // Match adds a Match query to the Query, matching documents where the specified field contains the given value.
 // This is useful for finding documents with similar text.
 func (inst *Query) Match(field string, value string) *Query {
@@ -24,6 +58,50 @@ func (inst *Query) Match(field string, value string) *Query {
 	return inst
 }
 
+// MultiMatch adds a MultiMatch query to the Query, matching documents where any of the specified fields contain the given value.
+// Fields may include wildcards and boosts, e.g. "title^2" or "*_name".
+func (inst *Query) MultiMatch(value string, fields ...string) *Query {
+	return inst.MultiMatchWithOptions(value, fields, TextQueryOptions{})
+}
+
+// MultiMatchWithOptions adds a MultiMatch query to the Query like MultiMatch, applying the given type, analyzer and operator.
+func (inst *Query) MultiMatchWithOptions(value string, fields []string, opts TextQueryOptions) *Query {
+	multiMatch := &types.MultiMatchQuery{Query: value, Fields: fields}
+	if opts.Type != "" {
+		multiMatch.Type = &textquerytype.TextQueryType{Name: opts.Type}
+	}
+	if opts.Analyzer != "" {
+		multiMatch.Analyzer = &opts.Analyzer
+	}
+	if opts.Operator != "" {
+		multiMatch.Operator = &operator.Operator{Name: opts.Operator}
+	}
+	inst.q.MultiMatch = multiMatch
+	return inst
+}
+
+// QueryString adds a QueryString query to the Query, matching documents using the Lucene query syntax,
+// e.g. "status:active AND (title:go OR title:golang)". If no fields are given, the index default fields are searched.
+func (inst *Query) QueryString(query string, fields ...string) *Query {
+	return inst.QueryStringWithOptions(query, fields, TextQueryOptions{})
+}
+
+// QueryStringWithOptions adds a QueryString query to the Query like QueryString, applying the given type, analyzer and default operator.
+func (inst *Query) QueryStringWithOptions(query string, fields []string, opts TextQueryOptions) *Query {
+	queryString := &types.QueryStringQuery{Query: query, Fields: fields}
+	if opts.Type != "" {
+		queryString.Type = &textquerytype.TextQueryType{Name: opts.Type}
+	}
+	if opts.Analyzer != "" {
+		queryString.Analyzer = &opts.Analyzer
+	}
+	if opts.Operator != "" {
+		queryString.DefaultOperator = &operator.Operator{Name: opts.Operator}
+	}
+	inst.q.QueryString = queryString
+	return inst
+}
+
 // MatchAll adds a MatchAll query to the Query, matching all documents in the index.
 func (inst *Query) MatchAll() *Query {
 	inst.q.MatchAll = types.NewMatchAllQuery()
@@ -39,6 +117,43 @@ func (inst *Query) Term(field string, value interface{}) *Query {
 	return inst
 }
 
+// Wildcard adds a Wildcard query to the Query, matching documents where the specified field matches the given pattern.
+// The pattern supports '*' for any sequence of characters and '?' for a single character.
+func (inst *Query) Wildcard(field string, pattern string) *Query {
+	inst.q.Wildcard = map[string]types.WildcardQuery{
+		field: {Value: &pattern},
+	}
+	return inst
+}
+
+// Prefix adds a Prefix query to the Query, matching documents where the specified field starts with the given value.
+// Useful for search-as-you-type features.
+func (inst *Query) Prefix(field string, value string) *Query {
+	inst.q.Prefix = map[string]types.PrefixQuery{
+		field: {Value: value},
+	}
+	return inst
+}
+
+// Fuzzy adds a Fuzzy query to the Query, matching documents where the specified field contains terms similar to the given value.
+// The fuzziness sets the maximum edit distance (e.g. "1", "2" or "AUTO"); an empty string uses the Elasticsearch default.
+func (inst *Query) Fuzzy(field string, value string, fuzziness string) *Query {
+	fuzzyQuery := types.FuzzyQuery{Value: value}
+	if fuzziness != "" {
+		fuzzyQuery.Fuzziness = fuzziness
+	}
+	inst.q.Fuzzy = map[string]types.FuzzyQuery{
+		field: fuzzyQuery,
+	}
+	return inst
+}
+
+// Exists adds an Exists query to the Query, matching documents that contain an indexed value for the specified field.
+func (inst *Query) Exists(field string) *Query {
+	inst.q.Exists = &types.ExistsQuery{Field: field}
+	return inst
+}
+
 // Range adds a Range query to the Query, matching documents where the specified field has values within a range.
 // Parameters gte (greater than or equal) and lte (less than or equal) specify the range boundaries.
 func (inst *Query) Range(field string, gte interface{}, lte interface{}) *Query {
@@ -99,6 +214,28 @@ func (inst *Query) Lte(field string, value interface{}) *Query {
 	return inst
 }
 
+// Nested adds a Nested query to the Query, matching documents with at least one object in the nested field at the given path
+// that matches the inner query. Fields in the inner query must use the full path, e.g. Nested("variants", NewQuery().Term("variants.color", "red")).
+// The inner query is copied, so changing it afterwards does not affect this Query.
+func (inst *Query) Nested(path string, query *Query) *Query {
+	inst.q.Nested = &types.NestedQuery{Path: path, Query: query.Clone().q}
+	return inst
+}
+
+// HasChild adds a HasChild query to the Query, matching parent documents whose child documents of the given join relation type
+// match the inner query. The inner query is copied, so changing it afterwards does not affect this Query.
+func (inst *Query) HasChild(childType string, query *Query) *Query {
+	inst.q.HasChild = &types.HasChildQuery{Type: childType, Query: query.Clone().q}
+	return inst
+}
+
+// HasParent adds a HasParent query to the Query, matching child documents whose parent document of the given join relation type
+// matches the inner query. The inner query is copied, so changing it afterwards does not affect this Query.
+func (inst *Query) HasParent(parentType string, query *Query) *Query {
+	inst.q.HasParent = &types.HasParentQuery{ParentType: parentType, Query: query.Clone().q}
+	return inst
+}
+
 // Must adds one or more 'must' conditions to the Bool query, where all conditions must match (AND).
 func (inst *Query) Must(queries ...*Query) *Query {
 	if inst.q.Bool == nil {
@@ -0,0 +1,73 @@
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// queryJSON returns the JSON encoding of the query.
+func queryJSON(t *testing.T, query *Query) string {
+	t.Helper()
+
+	data, err := json.Marshal(query.q)
+	if err != nil {
+		t.Fatalf("marshal query: %v", err)
+	}
+	return string(data)
+}
+
+func TestCloneIsIndependentOfBase(t *testing.T) {
+	base := NewQuery().Range("price", 10, 100)
+	want := queryJSON(t, base)
+
+	// Extend the range of the clone, which requires the copy to keep the map type of the range query.
+	clone := base.Clone().Gt("price", 20).Lt("stock", 5)
+	if got := queryJSON(t, base); got != want {
+		t.Fatalf("base changed after mutating clone: got %s, want %s", got, want)
+	}
+
+	if got := queryJSON(t, clone); got == want {
+		t.Fatalf("clone not mutated: %s", got)
+	}
+}
+
+func TestCloneCopiesNestedQueries(t *testing.T) {
+	inner := NewQuery().Range("variants.size", 1, 5)
+	base := NewQuery().Nested("variants", inner)
+	want := queryJSON(t, base)
+
+	clone := base.Clone()
+	clone.q.Nested.Query.Range["variants.size"].(map[string]interface{})["gte"] = 3
+	clone.q.Nested.Path = "options"
+
+	if got := queryJSON(t, base); got != want {
+		t.Fatalf("base changed after mutating clone: got %s, want %s", got, want)
+	}
+}
+
+func TestComposedQueriesCopyInnerQueries(t *testing.T) {
+	inner := NewQuery().Range("size", 1, 5)
+	nested := NewQuery().Nested("variants", inner)
+	child := NewQuery().HasChild("answer", inner)
+	parent := NewQuery().HasParent("question", inner)
+	wantNested, wantChild, wantParent := queryJSON(t, nested), queryJSON(t, child), queryJSON(t, parent)
+
+	// Extend the inner query after using it in all three parents.
+	inner.Gt("size", 3).Lt("stock", 10)
+
+	if got := queryJSON(t, nested); got != wantNested {
+		t.Errorf("Nested changed after mutating inner query: got %s, want %s", got, wantNested)
+	}
+	if got := queryJSON(t, child); got != wantChild {
+		t.Errorf("HasChild changed after mutating inner query: got %s, want %s", got, wantChild)
+	}
+	if got := queryJSON(t, parent); got != wantParent {
+		t.Errorf("HasParent changed after mutating inner query: got %s, want %s", got, wantParent)
+	}
+
+	// The parents must not share the inner query with each other either.
+	child.q.HasChild.Query.Range["size"].(map[string]interface{})["gte"] = 2
+	if got := queryJSON(t, parent); got != wantParent {
+		t.Errorf("HasParent changed after mutating HasChild: got %s, want %s", got, wantParent)
+	}
+}
@@ -0,0 +1,39 @@
+package elastic
+
+import "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+
+// SearchResult holds the metadata of a search response alongside the decoded documents.
+type SearchResult struct {
+	// TotalHits is the number of documents matching the query, which may be a lower bound (see TotalHitsRelation).
+	TotalHits int64
+
+	// TotalHitsRelation is "eq" if TotalHits is exact, or "gte" if it is a lower bound.
+	// Elasticsearch counts exactly up to 10,000 hits unless SearchOptions.TrackTotalHits is set.
+	TotalHitsRelation string
+
+	// MaxScore is the highest relevance score among the matching documents, or 0 if scores were not computed.
+	MaxScore float64
+
+	// Scores holds the relevance score of each returned document, in the same order as the documents.
+	Scores []float64
+}
+
+// newSearchResult is a helper function to extract the search result metadata from the response hits.
+func newSearchResult(hits types.HitsMetadata) *SearchResult {
+	result := &SearchResult{Scores: make([]float64, len(hits.Hits))}
+
+	if hits.Total != nil {
+		result.TotalHits = hits.Total.Value
+		result.TotalHitsRelation = hits.Total.Relation.String()
+	}
+	if hits.MaxScore != nil {
+		result.MaxScore = float64(*hits.MaxScore)
+	}
+	for i, hit := range hits.Hits {
+		if hit.Score_ != nil {
+			result.Scores[i] = float64(*hit.Score_)
+		}
+	}
+
+	return result
+}
@@ -1,32 +1,25 @@
 package elastic
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/get"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 )
 
 // SearchByID retrieves a single document by its unique ID from the specified index.
 // Unmarshals the document into the provided result object. Returns an error if the document is not found.
 func (inst *Service) SearchByID(index string, id string, result Document) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
-	defer cancel()
-
-	// Attempt to retrieve the document by ID
-	response, err := inst.client.Get(index, id).Do(ctx)
+	response, err := inst.get(index, id)
 	if err != nil {
-		return fmt.Errorf("%w: %s", ErrGettingDocument, err)
-	}
-
-	// Check if the document was found
-	if !response.Found {
-		return fmt.Errorf("%w with ID %s in index %s", ErrDocumentNotFound, id, index)
+		return err
 	}
 
 	// Unmarshal the source into the result object
-	if err := json.Unmarshal(response.Source_, result); err != nil {
+	if err := inst.serializer.Unmarshal(response.Source_, result); err != nil {
 		return fmt.Errorf("%w: %s", ErrUnmarshalingDocument, err)
 	}
 
@@ -38,29 +31,164 @@ func (inst *Service) SearchByID(index string, id string, result Document) error
 // Search performs a search query on the specified index with pagination and sorting options.
 // The matching documents are unmarshaled into the specified result slice, and document IDs are set.
 func (inst *Service) Search(index string, query *Query, limit int64, offset int64, sort []string, result interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	return inst.SearchWithOptions(index, query, limit, offset, sort, nil, result)
+}
+
+// SearchWithOptions performs a search query like Search, applying the given options such as _source filtering and highlighting.
+// A nil opts behaves exactly like Search.
+func (inst *Service) SearchWithOptions(index string, query *Query, limit int64, offset int64, sort []string, opts *SearchOptions, result interface{}) error {
+	response, err := inst.search(index, query, limit, offset, sort, opts)
+	if err != nil {
+		return err
+	}
+
+	return decodeHits(inst.serializer, response.Hits.Hits, result)
+}
+
+// SearchWithResult performs a search query like SearchWithOptions and additionally returns the total number of matching
+// documents, the maximum score and the score of each returned document. Documents implementing the Scorable interface
+// also receive their score.
+func (inst *Service) SearchWithResult(index string, query *Query, limit int64, offset int64, sort []string, opts *SearchOptions, result interface{}) (*SearchResult, error) {
+	response, err := inst.search(index, query, limit, offset, sort, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeHits(inst.serializer, response.Hits.Hits, result); err != nil {
+		return nil, err
+	}
+
+	return newSearchResult(response.Hits), nil
+}
+
+// SearchAfter performs a search query on the specified index using search_after pagination,
+// which is not limited by the index max result window and stays fast for deep pages.
+// The sort must be provided and should include a unique tie-breaker field. Pass a nil searchAfter for the first page,
+// then pass the returned sort values to fetch the following page. Nil sort values are returned when no documents match.
+func (inst *Service) SearchAfter(index string, query *Query, sort []string, searchAfter []interface{}, limit int64, result interface{}) ([]interface{}, error) {
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	// Prepare sorting options based on field prefixes
-	sortOptions := make(map[string]string, len(sort))
+	// Prepare the search request with sorting, resuming after the given sort values
+	request := inst.client.Search().Index(index).Query(query.q).Size(int(limit)).Sort(parseSort(sort)...)
+	if len(searchAfter) > 0 {
+		sortValues := make([]types.FieldValue, len(searchAfter))
+		for i, value := range searchAfter {
+			sortValues[i] = value
+		}
+		request.SearchAfter(sortValues...)
+	}
+
+	// Execute the search request
+	response, err := request.Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSearchingDocuments, err)
+	}
+
+	if err := decodeHits(inst.serializer, response.Hits.Hits, result); err != nil {
+		return nil, err
+	}
+
+	// Return the sort values of the last hit to resume from on the next page
+	hits := response.Hits.Hits
+	if len(hits) == 0 {
+		return nil, nil
+	}
+	lastSort := hits[len(hits)-1].Sort
+	nextSearchAfter := make([]interface{}, len(lastSort))
+	for i, value := range lastSort {
+		nextSearchAfter[i] = value
+	}
+
+	return nextSearchAfter, nil
+}
+
+// Aggregate runs the provided aggregations over the documents in the specified index that match the query,
+// without returning any documents. The aggregation results, keyed by aggregation name, are unmarshaled into
+// the result, which should mirror the Elasticsearch response (e.g. a struct with a "buckets" field for Terms).
+func (inst *Service) Aggregate(index string, query *Query, aggs *Aggregation, result interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the search request with size 0 so that only aggregations are returned
+	response, err := inst.client.Search().Index(index).Query(query.q).Size(0).Aggregations(aggs.aggs).TypedKeys(false).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrAggregatingDocuments, err)
+	}
+
+	// Re-encode the untyped aggregation results and decode them into the result
+	data, err := json.Marshal(response.Aggregations)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDecodingSearchResponse, err)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("%w: %s", ErrDecodingSearchResponse, err)
+	}
+
+	return nil
+}
+
+// get is a helper function to retrieve a document by ID, returning ErrDocumentNotFound if it does not exist.
+func (inst *Service) get(index string, id string) (*get.Response, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Attempt to retrieve the document by ID
+	response, err := inst.client.Get(index, id).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrGettingDocument, err)
+	}
+
+	// Check if the document was found
+	if !response.Found {
+		return nil, fmt.Errorf("%w with ID %s in index %s", ErrDocumentNotFound, id, index)
+	}
+
+	return response, nil
+}
+
+// search is a helper function to execute a search request with pagination, sorting and options.
+func (inst *Service) search(index string, query *Query, limit int64, offset int64, sort []string, opts *SearchOptions) (*search.Response, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Prepare the search request with pagination, sorting and options
+	request := inst.client.Search().Index(index).Query(query.q).Size(int(limit)).From(int(offset)).Sort(parseSort(sort)...)
+	if err := opts.apply(request); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSearchingDocuments, err)
+	}
+
+	// Execute the search request
+	response, err := request.Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSearchingDocuments, err)
+	}
+
+	return response, nil
+}
+
+// parseSort is a helper function to convert sort fields prefixed with '+' or '-' into Elasticsearch sort clauses.
+// The clauses are returned in the order the fields were given, so earlier fields take precedence on ties.
+func parseSort(sort []string) []types.SortCombinations {
+	sortOptions := make([]types.SortCombinations, 0, len(sort))
 	for _, field := range sort {
 		if len(field) > 0 {
 			if field[0] == '+' {
-				sortOptions[field[1:]] = "asc"
+				sortOptions = append(sortOptions, map[string]string{field[1:]: "asc"})
 			} else if field[0] == '-' {
-				sortOptions[field[1:]] = "desc"
+				sortOptions = append(sortOptions, map[string]string{field[1:]: "desc"})
 			} else {
-				sortOptions[field] = "asc" // Default to ascending if no prefix is provided
+				sortOptions = append(sortOptions, map[string]string{field: "asc"}) // Default to ascending if no prefix is provided
 			}
 		}
 	}
+	return sortOptions
+}
 
-	// Execute the search request with pagination and sorting
-	response, err := inst.client.Search().Index(index).Query(query.q).Size(int(limit)).From(int(offset)).Sort(sortOptions).Do(ctx)
-	if err != nil {
-		return fmt.Errorf("%w: %s", ErrSearchingDocuments, err)
-	}
-
+// decodeHits is a helper function to unmarshal search hits into the result slice with the serializer, setting document IDs.
+// The result must be a pointer to a slice whose elements implement the Document interface,
+// either directly (e.g. []*MyDoc with pointer receivers) or through a pointer (e.g. []MyDoc).
+func decodeHits(serializer Serializer, hits []types.Hit, result interface{}) error {
 	// Ensure result is a pointer to a slice of Document
 	resultVal := reflect.ValueOf(result)
 	if resultVal.Kind() != reflect.Ptr || resultVal.Elem().Kind() != reflect.Slice {
@@ -69,27 +197,35 @@ func (inst *Service) Search(index string, query *Query, limit int64, offset int6
 	resultSlice := resultVal.Elem()
 	elemType := resultSlice.Type().Elem()
 
-	// Ensure that the slice element implements the Document interface
+	// Determine the type to allocate for each element, so that it can be used as a Document
 	docType := reflect.TypeOf((*Document)(nil)).Elem()
-	if !elemType.Implements(docType) {
+	isPtr := elemType.Kind() == reflect.Ptr
+	allocType := elemType
+	if isPtr {
+		allocType = elemType.Elem()
+	}
+	if !reflect.PointerTo(allocType).Implements(docType) {
 		return fmt.Errorf("result slice elements must implement the Document interface")
 	}
 
 	// Populate result slice with documents, setting IDs
-	for _, hit := range response.Hits.Hits {
-		elem := reflect.New(elemType).Interface()
+	for _, hit := range hits {
+		elem := reflect.New(allocType)
 
 		// Unmarshal document data into the element
-		if err := json.Unmarshal(hit.Source_, elem); err != nil {
+		if err := serializer.Unmarshal(hit.Source_, elem.Interface()); err != nil {
 			return fmt.Errorf("%w: %s", ErrUnmarshalingDocuments, err)
 		}
 
 		// Set document ID using SetID
-		doc := elem.(Document)
-		doc.SetID(*hit.Id_)
+		setHitMetadata(elem.Interface().(Document), hit)
 
 		// Append the populated element to the result slice
-		resultSlice = reflect.Append(resultSlice, reflect.ValueOf(elem).Elem())
+		if isPtr {
+			resultSlice = reflect.Append(resultSlice, elem)
+		} else {
+			resultSlice = reflect.Append(resultSlice, elem.Elem())
+		}
 	}
 
 	// Set the modified result slice back to the original result pointer
@@ -97,3 +233,33 @@ func (inst *Service) Search(index string, query *Query, limit int64, offset int6
 
 	return nil
 }
+
+// setHitMetadata is a helper function to set the document ID and, for documents that accept them,
+// the highlighted snippets, relevance score and computed fields of a search hit.
+func setHitMetadata(doc Document, hit types.Hit) {
+	if hit.Id_ != nil {
+		doc.SetID(*hit.Id_)
+	}
+
+	// Pass highlighted snippets to documents that accept them
+	if highlightable, ok := doc.(Highlightable); ok && len(hit.Highlight) > 0 {
+		highlightable.SetHighlights(hit.Highlight)
+	}
+
+	// Pass the relevance score to documents that accept it
+	if scorable, ok := doc.(Scorable); ok && hit.Score_ != nil {
+		scorable.SetScore(float64(*hit.Score_))
+	}
+
+	// Pass computed script and runtime field values to documents that accept them
+	if fieldable, ok := doc.(Fieldable); ok && len(hit.Fields) > 0 {
+		fields := make(map[string][]interface{}, len(hit.Fields))
+		for name, raw := range hit.Fields {
+			var values []interface{}
+			if err := json.Unmarshal(raw, &values); err == nil {
+				fields[name] = values
+			}
+		}
+		fieldable.SetFields(fields)
+	}
+}
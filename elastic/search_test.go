@@ -0,0 +1,22 @@
+package elastic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+func TestParseSortKeepsFieldOrder(t *testing.T) {
+	want := []types.SortCombinations{
+		map[string]string{"score": "desc"},
+		map[string]string{"name": "asc"},
+	}
+
+	// Repeat the conversion to catch any order that depends on map iteration.
+	for i := 0; i < 50; i++ {
+		if got := parseSort([]string{"-score", "+name"}); !reflect.DeepEqual(got, want) {
+			t.Fatalf("run %d: got %v, want %v", i, got, want)
+		}
+	}
+}
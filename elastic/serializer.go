@@ -0,0 +1,26 @@
+package elastic
+
+import "encoding/json"
+
+// Serializer encodes documents into JSON before they are indexed or updated, and decodes the JSON
+// sources of retrieved documents. It can be set in Config to use a faster or more precise JSON library.
+type Serializer interface {
+	// Marshal encodes the value into JSON.
+	Marshal(v interface{}) ([]byte, error)
+
+	// Unmarshal decodes the JSON data into the value pointed to by v.
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// jsonSerializer is the default Serializer, based on the standard encoding/json package.
+type jsonSerializer struct{}
+
+// Marshal encodes the value into JSON using encoding/json.
+func (jsonSerializer) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes the JSON data into the value pointed to by v using encoding/json.
+func (jsonSerializer) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
@@ -3,45 +3,72 @@ package elastic
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	elasticsearch "github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/core/count"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/refresh"
+	"github.com/nguyendang2000/shared-go/health"
+	"github.com/nguyendang2000/shared-go/shutdown"
 )
 
 // Service represents an Elasticsearch service with a configured client and timeout setting.
 type Service struct {
-	client  *elasticsearch.TypedClient
-	timeout int64
+	client         *elasticsearch.TypedClient
+	transport      *http.Transport   // HTTP transport used by the client, kept to release connections on Close.
+	timeout        int64             // Timeout for Elasticsearch operations, in milliseconds.
+	refreshOnWrite bool              // Whether write operations wait for a refresh before returning.
+	inflight       *shutdown.Tracker // Tracks in-flight requests for Shutdown.
+	serializer     Serializer        // Serializer used to encode and decode documents.
 }
 
 // NewService initializes a new Elasticsearch service with the provided configuration.
 // Returns an error if required configuration fields are missing or if the client cannot be created.
+// If Config.VerifyConnection is set, the cluster is also pinged and an error is returned if it is unreachable.
 func NewService(conf Config) (*Service, error) {
 	if len(conf.Addresses) == 0 {
 		return nil, ErrNoAddresses
 	}
 
-	// Prepare Elasticsearch configuration
+	// Prepare a dedicated HTTP transport so its connections can be released on Close
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	// Optional: Load CA certificate
+	var caCert []byte
+	if conf.CACert != "" {
+		var err error
+		caCert, err = os.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, ErrOpeningCACert
+		}
+	}
+
+	// Optional: Trust the CA certificate and check the certificate fingerprint, if provided
+	if err := configureTLS(transport, caCert, conf.CertificateFingerprint); err != nil {
+		return nil, err
+	}
+
+	// Prepare Elasticsearch configuration, tracking each request so Shutdown can wait for it
+	inflight := &shutdown.Tracker{}
 	esConfig := elasticsearch.Config{
 		Addresses: conf.Addresses,
 		Username:  conf.Username,
 		Password:  conf.Password,
+		Transport: shutdown.NewTransport(transport, inflight),
 	}
 
-	// Optional: Add CertificateFingerprint if provided
-	if conf.CertificateFingerprint != "" {
-		esConfig.CertificateFingerprint = conf.CertificateFingerprint
+	// Optional: Record each API call with the metrics collector and trace it with the tracer provider
+	var instruments instrumentations
+	if conf.MetricsCollector != nil {
+		instruments = append(instruments, metricsInstrumentation{collector: conf.MetricsCollector})
 	}
-
-	// Optional: Load CA certificate
-	if conf.CACert != "" {
-		caCert, err := os.ReadFile(conf.CACert)
-		if err != nil {
-			return nil, ErrOpeningCACert
-		}
-		esConfig.CACert = caCert
+	if conf.TracerProvider != nil {
+		instruments = append(instruments, elasticsearch.NewOpenTelemetryInstrumentation(conf.TracerProvider, false))
+	}
+	if len(instruments) > 0 {
+		esConfig.Instrumentation = instruments
 	}
 
 	// Set timeout
@@ -50,13 +77,86 @@ func NewService(conf Config) (*Service, error) {
 		timeout = DefaultTimeout
 	}
 
+	// Set document serializer, defaulting to encoding/json
+	serializer := conf.Serializer
+	if serializer == nil {
+		serializer = jsonSerializer{}
+	}
+
 	// Create Elasticsearch client
 	client, err := elasticsearch.NewTypedClient(esConfig)
 	if err != nil {
 		return nil, ErrCreatingElasticClient
 	}
 
-	return &Service{client: client, timeout: timeout}, nil
+	service := &Service{client: client, transport: transport, timeout: timeout, refreshOnWrite: conf.RefreshOnWrite, serializer: serializer, inflight: inflight}
+
+	// Optional: Verify the connection with a ping, retrying if configured
+	if conf.VerifyConnection {
+		connectBackoff := conf.ConnectBackoff
+		if connectBackoff <= 0 {
+			connectBackoff = DefaultConnectBackoff
+		}
+		err := health.WaitFor(context.Background(), conf.ConnectAttempts, time.Duration(connectBackoff)*time.Millisecond, service.Ping)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return service, nil
+}
+
+// Ping checks if the Elasticsearch cluster is reachable.
+// Returns an error if the request fails or the cluster does not respond successfully.
+func (inst *Service) Ping() error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the ping request
+	ok, err := inst.client.Ping().Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPingingElastic, err)
+	}
+	if !ok {
+		return ErrPingingElastic
+	}
+
+	return nil
+}
+
+// Check verifies that the Elasticsearch cluster is reachable using the given context.
+// It implements the health.Checker interface.
+func (inst *Service) Check(ctx context.Context) error {
+	ok, err := inst.client.Ping().Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPingingElastic, err)
+	}
+	if !ok {
+		return ErrPingingElastic
+	}
+
+	return nil
+}
+
+// Close stops accepting new requests, which then fail with shutdown.ErrShuttingDown,
+// and releases the idle connections held by the Elasticsearch client.
+// Unlike Shutdown, it does not wait for the in-flight requests to complete.
+func (inst *Service) Close() error {
+	inst.inflight.Stop()
+	inst.transport.CloseIdleConnections()
+	return nil
+}
+
+// Shutdown gracefully closes the service: it stops accepting new requests, which then fail with shutdown.ErrShuttingDown,
+// waits for the in-flight ones to complete and releases the idle connections.
+// The connections are released even if ctx is done first, in which case the context's error is returned.
+func (inst *Service) Shutdown(ctx context.Context) error {
+	drainErr := inst.inflight.Drain(ctx)
+	if err := inst.Close(); err != nil {
+		return err
+	}
+
+	return drainErr
 }
 
 // Client returns the internal Elasticsearch client, allowing direct API access.
@@ -64,9 +164,23 @@ func (inst *Service) Client() *elasticsearch.TypedClient {
 	return inst.client
 }
 
+// getTimeout returns a new context with the timeout specified in the Service.
+// The timeout is expressed in milliseconds, matching Config.Timeout and DefaultTimeout.
+func (inst *Service) getTimeout() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+}
+
+// refreshPolicy returns the refresh policy applied to write operations based on the Service configuration.
+func (inst *Service) refreshPolicy() refresh.Refresh {
+	if inst.refreshOnWrite {
+		return refresh.Waitfor
+	}
+	return refresh.False
+}
+
 // Count returns the number of documents in a specified index that match the provided query.
 func (inst *Service) Count(index string, query Query) (int64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Millisecond)
+	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
 	// Execute the count request with the provided query
@@ -83,14 +197,12 @@ func (inst *Service) Count(index string, query Query) (int64, error) {
 // Exists checks if there is at least one document in the specified index that matches the provided query.
 // Returns true if any matching document exists, false otherwise.
 func (inst *Service) Exists(index string, query *Query) (bool, error) {
-	var result []map[string]interface{}
-
 	// Perform a search with limit 1 to check for document existence
-	err := inst.Search(index, query, 1, 0, nil, &result)
+	response, err := inst.search(index, query, 1, 0, nil, nil)
 	if err != nil {
 		return false, fmt.Errorf("%w: %s", ErrCheckingDocumentExists, err)
 	}
 
 	// Return true if any document was found
-	return len(result) > 0, nil
+	return len(response.Hits.Hits) > 0, nil
 }
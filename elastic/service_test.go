@@ -0,0 +1,93 @@
+package elastic
+
+import (
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nguyendang2000/shared-go/shutdown"
+)
+
+func TestGetTimeoutUsesMilliseconds(t *testing.T) {
+	service := &Service{timeout: 1500}
+
+	start := time.Now()
+	ctx, cancel := service.getTimeout()
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("context has no deadline")
+	}
+
+	// Allow some slack for the time between reading the clock and creating the context.
+	want := 1500 * time.Millisecond
+	if got := deadline.Sub(start); got < want || got > want+time.Second {
+		t.Fatalf("deadline %s from now, want about %s", got, want)
+	}
+}
+
+func TestCloseReleasesClientConnectionsWithCACert(t *testing.T) {
+	closed := make(chan struct{}, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateClosed {
+			closed <- struct{}{}
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	// Trust the test server certificate through Config.CACert
+	caCert := filepath.Join(t.TempDir(), "ca.pem")
+	pemCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caCert, pemCert, 0600); err != nil {
+		t.Fatalf("write CA certificate: %v", err)
+	}
+
+	service, err := NewService(Config{Addresses: []string{server.URL}, CACert: caCert})
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	if err := service.Ping(); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+
+	// The idle connection of the ping must be the one released by Close
+	if err := service.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection used by the client not released on Close")
+	}
+}
+
+func TestCloseRejectsLaterRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	}))
+	defer server.Close()
+
+	service, err := NewService(Config{Addresses: []string{server.URL}})
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	if err := service.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	err = service.Ping()
+	if err == nil || !strings.Contains(err.Error(), shutdown.ErrShuttingDown.Error()) {
+		t.Fatalf("got %v after Close, want %v", err, shutdown.ErrShuttingDown)
+	}
+}
@@ -0,0 +1,57 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// configureTLS is a helper function to trust the CA certificate and check the certificate fingerprint on the HTTP transport.
+// The Elasticsearch client only applies them to an unwrapped *http.Transport, so they are applied here before the
+// transport is wrapped. Either value may be empty.
+func configureTLS(transport *http.Transport, caCert []byte, fingerprint string) error {
+	// Trust the CA certificate for server verification
+	if len(caCert) > 0 {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+		if !transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return ErrParsingCACert
+		}
+	}
+
+	// Accept only servers presenting a certificate matching the fingerprint
+	if fingerprint != "" {
+		expected, err := hex.DecodeString(fingerprint)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidFingerprint, err)
+		}
+
+		dialer := &tls.Dialer{Config: &tls.Config{InsecureSkipVerify: true}}
+		transport.DialTLSContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := dialer.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, cert := range conn.(*tls.Conn).ConnectionState().PeerCertificates {
+				digest := sha256.Sum256(cert.Raw)
+				if bytes.Equal(digest[:], expected) {
+					return conn, nil
+				}
+			}
+			conn.Close()
+
+			return nil, fmt.Errorf("%w: %s", ErrFingerprintMismatch, fingerprint)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package elastic
+
+import (
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// Index is a typed view of a single Elasticsearch index whose documents decode into values of type T.
+// T is usually a pointer to a struct implementing Document with pointer receivers (e.g. *MyDoc).
+// It is built on the Service methods, so it shares their timeouts and error handling.
+type Index[T Document] struct {
+	service *Service
+	index   string
+}
+
+// NewIndex returns a typed view of the given index.
+func NewIndex[T Document](service *Service, index string) *Index[T] {
+	return &Index[T]{service: service, index: index}
+}
+
+// SearchByID retrieves a single document by its unique ID.
+// Returns an error wrapping ErrDocumentNotFound if the document does not exist.
+func (inst *Index[T]) SearchByID(id string) (T, error) {
+	var result T
+
+	response, err := inst.service.get(inst.index, id)
+	if err != nil {
+		return result, err
+	}
+
+	// Unmarshal the source, allocating the document if T is a pointer
+	if err := inst.service.serializer.Unmarshal(response.Source_, &result); err != nil {
+		return result, fmt.Errorf("%w: %s", ErrUnmarshalingDocument, err)
+	}
+	result.SetID(response.Id_)
+
+	return result, nil
+}
+
+// Search performs a search query with pagination and sorting options and returns the matching documents.
+func (inst *Index[T]) Search(query *Query, limit int64, offset int64, sort []string) ([]T, error) {
+	return inst.SearchWithOptions(query, limit, offset, sort, nil)
+}
+
+// SearchWithOptions performs a search query like Search, applying the given options such as _source filtering and highlighting.
+func (inst *Index[T]) SearchWithOptions(query *Query, limit int64, offset int64, sort []string, opts *SearchOptions) ([]T, error) {
+	response, err := inst.service.search(inst.index, query, limit, offset, sort, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeTypedHits[T](inst.service.serializer, response.Hits.Hits)
+}
+
+// SearchWithResult performs a search query like SearchWithOptions and additionally returns the total number of matching
+// documents, the maximum score and the score of each returned document.
+func (inst *Index[T]) SearchWithResult(query *Query, limit int64, offset int64, sort []string, opts *SearchOptions) ([]T, *SearchResult, error) {
+	response, err := inst.service.search(inst.index, query, limit, offset, sort, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docs, err := decodeTypedHits[T](inst.service.serializer, response.Hits.Hits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return docs, newSearchResult(response.Hits), nil
+}
+
+// Count returns the number of documents that match the provided query.
+func (inst *Index[T]) Count(query *Query) (int64, error) {
+	return inst.service.Count(inst.index, *query)
+}
+
+// IndexOne indexes or updates a single document.
+func (inst *Index[T]) IndexOne(doc T) error {
+	return inst.service.IndexOne(inst.index, doc)
+}
+
+// Index indexes multiple documents in a single bulk request.
+func (inst *Index[T]) Index(docs ...T) error {
+	values := make([]Document, len(docs))
+	for i, doc := range docs {
+		values[i] = doc
+	}
+
+	return inst.service.Index(inst.index, values)
+}
+
+// UpdateByID applies a partial update to the document with the given ID.
+func (inst *Index[T]) UpdateByID(id string, partial interface{}) error {
+	return inst.service.UpdateByID(inst.index, id, partial)
+}
+
+// DeleteByID deletes the document with the given ID.
+func (inst *Index[T]) DeleteByID(id string) error {
+	return inst.service.DeleteByID(inst.index, id)
+}
+
+// decodeTypedHits is a helper function to unmarshal search hits into documents of type T with the serializer, setting document IDs.
+func decodeTypedHits[T Document](serializer Serializer, hits []types.Hit) ([]T, error) {
+	result := make([]T, 0, len(hits))
+	for _, hit := range hits {
+		var doc T
+
+		// Unmarshal the source, allocating the document if T is a pointer
+		if err := serializer.Unmarshal(hit.Source_, &doc); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnmarshalingDocuments, err)
+		}
+		setHitMetadata(doc, hit)
+
+		result = append(result, doc)
+	}
+
+	return result, nil
+}
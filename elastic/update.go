@@ -0,0 +1,51 @@
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// UpdateByID partially updates a document by its unique ID in the specified index.
+// Only the fields present in partial are changed; the update is retried up to DefaultRetryOnConflict times on version conflicts.
+// Returns an error if the document does not exist or could not be updated.
+func (inst *Service) UpdateByID(index string, id string, partial interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Encode the partial document with the configured serializer
+	data, err := inst.serializer.Marshal(partial)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrMarshalingDocument, err)
+	}
+
+	// Execute the update request with the partial document
+	_, err = inst.client.Update(index, id).Doc(json.RawMessage(data)).RetryOnConflict(DefaultRetryOnConflict).Refresh(inst.refreshPolicy()).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUpdatingDocument, err)
+	}
+
+	return nil
+}
+
+// UpdateByQuery updates all documents in the specified index that match the provided query by running the given Painless script,
+// e.g. "ctx._source.status = 'archived'". The operation aborts if a version conflict occurs.
+// Returns an error if the update-by-query operation encounters issues.
+func (inst *Service) UpdateByQuery(index string, query *Query, script string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Execute the update-by-query request with the script
+	response, err := inst.client.UpdateByQuery(index).Query(query.q).Script(&types.Script{Source: &script}).Refresh(inst.refreshOnWrite).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrUpdatingDocuments, err)
+	}
+
+	// Check for errors in the update response
+	if len(response.Failures) > 0 {
+		return fmt.Errorf("%w: encountered failures during update-by-query", ErrUpdatingDocuments)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Checker defines the interface for a dependency whose health can be checked.
+// The redis, mongo, minio and elastic Service types all implement it.
+type Checker interface {
+	// Check verifies that the dependency is reachable, returning an error if it is not.
+	Check(ctx context.Context) error
+}
+
+// Status represents the result of checking a single dependency.
+type Status struct {
+	// Name is the name under which the dependency was registered.
+	Name string `json:"name"`
+
+	// Healthy indicates whether the check succeeded.
+	Healthy bool `json:"healthy"`
+
+	// Latency is the time the check took to complete.
+	Latency time.Duration `json:"-"`
+
+	// LatencyMs is the time the check took to complete, in milliseconds.
+	LatencyMs int64 `json:"latency_ms"`
+
+	// Error holds the error message of a failed check.
+	Error string `json:"error,omitempty"`
+}
+
+// Report represents the aggregated result of checking multiple dependencies.
+type Report struct {
+	// Healthy indicates whether all checks succeeded.
+	Healthy bool `json:"healthy"`
+
+	// Checks holds the status of each dependency, sorted by name.
+	Checks []Status `json:"checks"`
+}
+
+// Check runs the check of a single dependency and measures its latency.
+func Check(ctx context.Context, name string, checker Checker) Status {
+	start := time.Now()
+	err := checker.Check(ctx)
+	latency := time.Since(start)
+
+	status := Status{
+		Name:      name,
+		Healthy:   err == nil,
+		Latency:   latency,
+		LatencyMs: latency.Milliseconds(),
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// CheckAll runs the checks of all given dependencies concurrently and aggregates their results.
+// The report is healthy only if every check succeeds, and its checks are sorted by name.
+// Use a context with a deadline to bound the total time spent checking.
+func CheckAll(ctx context.Context, checkers map[string]Checker) Report {
+	// Sort the names so the report order is stable.
+	names := make([]string, 0, len(checkers))
+	for name := range checkers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := Report{
+		Healthy: true,
+		Checks:  make([]Status, len(names)),
+	}
+
+	// Run the checks concurrently, each writing to its own slot.
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			report.Checks[i] = Check(ctx, name, checkers[name])
+		}(i, name)
+	}
+	wg.Wait()
+
+	// Aggregate the overall health.
+	for _, status := range report.Checks {
+		if !status.Healthy {
+			report.Healthy = false
+		}
+	}
+
+	return report
+}
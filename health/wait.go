@@ -0,0 +1,30 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// WaitFor calls check until it succeeds, up to attempts times, so a service can wait for a dependency
+// that is still starting. Between attempts it sleeps for backoff, doubling the delay after each failure.
+// Attempts below 1 are treated as a single attempt. It returns the error of the last attempt if all of them fail,
+// or the context's error if ctx is canceled while waiting.
+func WaitFor(ctx context.Context, attempts int, backoff time.Duration, check func() error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = check(); err == nil || attempt >= attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
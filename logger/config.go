@@ -2,12 +2,14 @@ package logger
 
 import (
 	"fmt"
+
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Config defines the configuration for the logger.
 // It includes options for the log type, log level, output location, and format.
 type Config struct {
-	// Type specifies the type of logger to use (e.g., "zap", "default").
+	// Type specifies the type of logger to use (e.g., "zap", "default", "noop").
 	Type LoggerType `yaml:"type"`
 
 	// Level defines the log level (e.g., "info", "error", "debug").
@@ -17,15 +19,63 @@ type Config struct {
 	Output LogOutput `yaml:"output"`
 
 	// Format determines the log format (e.g., "json", "plain").
+	// With Zap, both formats use production settings: stack traces are added from the error level
+	// and DPanic messages do not panic.
 	Format LogFormat `yaml:"format"`
+
+	// MaxSize is the maximum size in megabytes of the log file before it is rotated.
+	// Rotation only applies when Output is a file path and any rotation field is set.
+	MaxSize int `yaml:"max_size"`
+
+	// MaxAge is the maximum number of days to retain rotated log files.
+	// This field is optional; by default old files are not removed based on age.
+	MaxAge int `yaml:"max_age"`
+
+	// MaxBackups is the maximum number of rotated log files to retain.
+	// This field is optional; by default all rotated files are retained.
+	MaxBackups int `yaml:"max_backups"`
+
+	// SampleInitial is the number of identical messages logged per second before sampling starts.
+	// Sampling is enabled when this field is set; otherwise every message is logged.
+	SampleInitial int `yaml:"sample_initial"`
+
+	// SampleThereafter sets that, once SampleInitial is reached, only every Nth identical message
+	// within the same second is logged. A value of 0 drops all of them.
+	SampleThereafter int `yaml:"sample_thereafter"`
+}
+
+// isFileOutput reports whether the configuration logs to a file rather than stdout.
+func (conf Config) isFileOutput() bool {
+	return conf.Output != LogOutputStdout && conf.Output != ""
+}
+
+// isRotationEnabled reports whether the log file should be rotated.
+// Rotation is enabled when logging to a file and any rotation limit is configured.
+func (conf Config) isRotationEnabled() bool {
+	return conf.isFileOutput() && (conf.MaxSize > 0 || conf.MaxAge > 0 || conf.MaxBackups > 0)
+}
+
+// isSamplingEnabled reports whether identical messages should be sampled.
+func (conf Config) isSamplingEnabled() bool {
+	return conf.SampleInitial > 0
+}
+
+// newRotatingWriter creates a writer for the configured log file that rotates it based on the rotation limits.
+func newRotatingWriter(conf Config) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   string(conf.Output),
+		MaxSize:    conf.MaxSize,
+		MaxAge:     conf.MaxAge,
+		MaxBackups: conf.MaxBackups,
+	}
 }
 
-// warnForDefaultLogger outputs a warning if the log level or formatting options
+// warnForDefaultLogger outputs a warning if formatting options
 // are provided for the default Go logger, as these options are ignored.
 // This function helps inform users that certain configuration settings are not applicable.
 func warnForDefaultLogger(conf Config) {
-	if conf.Level != "" || conf.Format != "" {
-		fmt.Println("Warning: Log level and formatting are ignored when using the default Go logger.")
+	if conf.Format != "" {
+		fmt.Println("Warning: Log formatting is ignored when using the default Go logger.")
 	}
 }
 
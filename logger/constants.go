@@ -16,6 +16,7 @@ const (
 	// Logger Types
 	LoggerZap     LoggerType = "zap"     // Zap logger, a structured logger for high-performance logging.
 	LoggerDefault LoggerType = "default" // Default Go logger, suitable for basic logging needs.
+	LoggerNoop    LoggerType = "noop"    // No-op logger, discarding all messages.
 
 	// Log Levels
 	LogLevelDebug LogLevel = "debug" // Debug log level, used for detailed system information.
@@ -32,4 +33,32 @@ const (
 	// Log Formats
 	LogFormatJSON  LogFormat = "json"  // JSON log format, suitable for structured and machine-readable logs.
 	LogFormatPlain LogFormat = "plain" // Plain text log format, suitable for human-readable logs.
+
+	// Field Names
+	FieldError     = "error"      // Field name under which errors attached with WithError are logged.
+	FieldTraceID   = "trace_id"   // Field name under which the context trace ID is logged.
+	FieldRequestID = "request_id" // Field name under which the context request ID is logged.
 )
+
+// logLevelSeverity maps each log level to its severity, from least to most severe.
+var logLevelSeverity = map[LogLevel]int{
+	LogLevelDebug: 0,
+	LogLevelInfo:  1,
+	LogLevelWarn:  2,
+	LogLevelError: 3,
+	LogLevelFatal: 4,
+	LogLevelPanic: 5,
+}
+
+// normalizeLevel returns the given level if it is supported, or LogLevelInfo otherwise.
+func normalizeLevel(level LogLevel) LogLevel {
+	if _, ok := logLevelSeverity[level]; ok {
+		return level
+	}
+	return LogLevelInfo
+}
+
+// enabled reports whether messages at this level should be written by a logger set to the given minimum level.
+func (level LogLevel) enabled(minimum LogLevel) bool {
+	return logLevelSeverity[level] >= logLevelSeverity[minimum]
+}
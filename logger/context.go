@@ -0,0 +1,51 @@
+package logger
+
+import "context"
+
+// contextKey defines a private type for values stored in a context by this package.
+type contextKey string
+
+const (
+	contextKeyLogger    contextKey = "logger"     // Context key for the request-scoped logger.
+	contextKeyTraceID   contextKey = "trace_id"   // Context key for the trace ID.
+	contextKeyRequestID contextKey = "request_id" // Context key for the request ID.
+)
+
+// ContextWithTraceID returns a copy of the context carrying the given trace ID,
+// which is logged by loggers created with WithContext.
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, contextKeyTraceID, traceID)
+}
+
+// ContextWithRequestID returns a copy of the context carrying the given request ID,
+// which is logged by loggers created with WithContext.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKeyRequestID, requestID)
+}
+
+// NewContext returns a copy of the context carrying the given logger, retrievable with FromContext.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, contextKeyLogger, l)
+}
+
+// FromContext returns the logger stored in the context, bound to the context's well-known values.
+// If no logger is stored, the global logger is used, which panics if it is not set either.
+func FromContext(ctx context.Context) Logger {
+	l, ok := ctx.Value(contextKeyLogger).(Logger)
+	if !ok {
+		l = GlobalLogger()
+	}
+	return l.WithContext(ctx)
+}
+
+// contextFields extracts the well-known values stored in the context as logger fields.
+func contextFields(ctx context.Context) map[string]interface{} {
+	fields := make(map[string]interface{}, 2)
+	if traceID, ok := ctx.Value(contextKeyTraceID).(string); ok && traceID != "" {
+		fields[FieldTraceID] = traceID
+	}
+	if requestID, ok := ctx.Value(contextKeyRequestID).(string); ok && requestID != "" {
+		fields[FieldRequestID] = requestID
+	}
+	return fields
+}
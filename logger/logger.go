@@ -1,5 +1,7 @@
 package logger
 
+import "context"
+
 // Logger defines the interface for a logging system that supports different log levels and formatting.
 type Logger interface {
 	// Debug logs a debug-level message.
@@ -27,6 +29,17 @@ type Logger interface {
 	Fatalf(format string, args ...interface{})
 	// Panicf logs a formatted panic-level message and panics.
 	Panicf(format string, args ...interface{})
+
+	// WithFields returns a child logger that attaches the given key-value fields to every message it logs.
+	// Fields are added to those already carried by the parent logger, which is left unchanged.
+	WithFields(fields map[string]interface{}) Logger
+
+	// WithError returns a child logger that attaches the given error under the "error" field to every message it logs.
+	WithError(err error) Logger
+
+	// WithContext returns a child logger that attaches the well-known values stored in the context,
+	// such as the trace ID and request ID, to every message it logs.
+	WithContext(ctx context.Context) Logger
 }
 
 var globalLogger Logger // The global logger instance.
@@ -46,11 +59,13 @@ func GlobalLogger() Logger {
 }
 
 // New initializes a new logger based on the provided configuration.
-// It returns a Zap logger if specified; otherwise, it defaults to the standard Go logger.
+// It returns a Zap or no-op logger if specified; otherwise, it defaults to the standard Go logger.
 func New(conf Config) Logger {
 	switch conf.Type {
 	case "zap":
 		return newZapLogger(conf)
+	case LoggerNoop:
+		return NewNoop()
 	default:
 		warnForDefaultLogger(conf)
 		warnConcurrency()
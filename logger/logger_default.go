@@ -1,21 +1,33 @@
 package logger
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"sort"
+	"strings"
 )
 
 // defaultLogger implements the Logger interface using the Go standard log package.
 type defaultLogger struct {
-	logger *log.Logger // The Go standard logger instance.
+	logger  *log.Logger            // The Go standard logger instance.
+	level   LogLevel               // The minimum level of messages that are written.
+	sampler *sampler               // Sampler limiting identical messages, or nil if sampling is disabled.
+	fields  map[string]interface{} // Fields appended to every message.
+	suffix  string                 // Pre-rendered fields, appended to every message.
 }
 
 // newDefaultLogger initializes the default Go logger based on the provided configuration.
 // It logs to the specified output location, or defaults to stdout if no output is provided.
+// Messages below the configured level are discarded, defaulting to the info level,
+// and identical messages are sampled if sampling is configured. When rotation limits are configured, the log file is rotated accordingly.
 func newDefaultLogger(conf Config) Logger {
-	output := os.Stdout
-	if conf.Output != LogOutputStdout && conf.Output != "" {
+	var output io.Writer = os.Stdout
+	if conf.isRotationEnabled() {
+		output = newRotatingWriter(conf)
+	} else if conf.isFileOutput() {
 		f, err := os.OpenFile(string(conf.Output), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if err != nil {
 			log.Fatalf("failed to open log file: %v", err)
@@ -25,69 +37,124 @@ func newDefaultLogger(conf Config) Logger {
 
 	// Initialize the Go standard logger with the output and default flags.
 	stdLogger := log.New(output, "", log.LstdFlags)
-	return &defaultLogger{logger: stdLogger}
+	// Initialize the sampler if sampling is configured.
+	var msgSampler *sampler
+	if conf.isSamplingEnabled() {
+		msgSampler = newSampler(conf.SampleInitial, conf.SampleThereafter)
+	}
+
+	return &defaultLogger{logger: stdLogger, level: normalizeLevel(conf.Level), sampler: msgSampler}
+}
+
+// output writes a message with the given level prefix, followed by the logger's fields.
+// Messages below the logger's level, or dropped by the sampler, are discarded.
+func (inst *defaultLogger) output(level LogLevel, msg string) {
+	if !level.enabled(inst.level) {
+		return
+	}
+	if inst.sampler != nil && !inst.sampler.allow(string(level)+msg) {
+		return
+	}
+	inst.logger.Println(strings.ToUpper(string(level)) + ": " + msg + inst.suffix)
 }
 
 // Debug logs a debug-level message.
 func (inst *defaultLogger) Debug(msg string) {
-	inst.logger.Println("DEBUG: " + msg)
+	inst.output(LogLevelDebug, msg)
 }
 
 // Info logs an info-level message.
 func (inst *defaultLogger) Info(msg string) {
-	inst.logger.Println("INFO: " + msg)
+	inst.output(LogLevelInfo, msg)
 }
 
 // Warn logs a warning-level message.
 func (inst *defaultLogger) Warn(msg string) {
-	inst.logger.Println("WARN: " + msg)
+	inst.output(LogLevelWarn, msg)
 }
 
 // Error logs an error-level message.
 func (inst *defaultLogger) Error(msg string) {
-	inst.logger.Println("ERROR: " + msg)
+	inst.output(LogLevelError, msg)
 }
 
 // Fatal logs a fatal-level message and exits the application.
 func (inst *defaultLogger) Fatal(msg string) {
-	inst.logger.Println("FATAL: " + msg)
+	inst.output(LogLevelFatal, msg)
 	os.Exit(1)
 }
 
 // Panic logs a panic-level message and panics.
 func (inst *defaultLogger) Panic(msg string) {
-	inst.logger.Println("PANIC: " + msg)
+	inst.output(LogLevelPanic, msg)
 	panic(msg)
 }
 
 // Debugf logs a formatted debug-level message.
 func (inst *defaultLogger) Debugf(format string, args ...interface{}) {
-	inst.logger.Printf("DEBUG: "+format, args...)
+	inst.output(LogLevelDebug, fmt.Sprintf(format, args...))
 }
 
 // Infof logs a formatted info-level message.
 func (inst *defaultLogger) Infof(format string, args ...interface{}) {
-	inst.logger.Printf("INFO: "+format, args...)
+	inst.output(LogLevelInfo, fmt.Sprintf(format, args...))
 }
 
 // Warnf logs a formatted warning-level message.
 func (inst *defaultLogger) Warnf(format string, args ...interface{}) {
-	inst.logger.Printf("WARN: "+format, args...)
+	inst.output(LogLevelWarn, fmt.Sprintf(format, args...))
 }
 
 // Errorf logs a formatted error-level message.
 func (inst *defaultLogger) Errorf(format string, args ...interface{}) {
-	inst.logger.Printf("ERROR: "+format, args...)
+	inst.output(LogLevelError, fmt.Sprintf(format, args...))
 }
 
 // Fatalf logs a formatted fatal-level message and exits the application.
 func (inst *defaultLogger) Fatalf(format string, args ...interface{}) {
-	inst.logger.Printf("FATAL: "+format, args...)
+	inst.output(LogLevelFatal, fmt.Sprintf(format, args...))
 	os.Exit(1)
 }
 
 // Panicf logs a formatted panic-level message and panics.
 func (inst *defaultLogger) Panicf(format string, args ...interface{}) {
-	inst.logger.Printf("PANIC: "+format, args...)
-	panic(fmt.Sprintf(format, args...))
+	msg := fmt.Sprintf(format, args...)
+	inst.output(LogLevelPanic, msg)
+	panic(msg)
+}
+
+// WithFields returns a child logger that appends the given fields to every message as key=value pairs, sorted by key.
+func (inst *defaultLogger) WithFields(fields map[string]interface{}) Logger {
+	// Merge the parent fields with the new ones, letting the new ones take precedence.
+	merged := make(map[string]interface{}, len(inst.fields)+len(fields))
+	for key, value := range inst.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	// Render the fields once so that logging does not pay the cost on every message.
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var suffix strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&suffix, " %s=%v", key, merged[key])
+	}
+
+	return &defaultLogger{logger: inst.logger, level: inst.level, sampler: inst.sampler, fields: merged, suffix: suffix.String()}
+}
+
+// WithError returns a child logger that appends the error message to every message as an error=... pair.
+func (inst *defaultLogger) WithError(err error) Logger {
+	return inst.WithFields(map[string]interface{}{FieldError: err})
+}
+
+// WithContext returns a child logger that attaches the trace ID and request ID stored in the context.
+func (inst *defaultLogger) WithContext(ctx context.Context) Logger {
+	return inst.WithFields(contextFields(ctx))
 }
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+)
+
+// newBufferedLogger returns a default logger at the given level writing to the returned buffer.
+func newBufferedLogger(level LogLevel) (*defaultLogger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return &defaultLogger{logger: log.New(&buf, "", 0), level: level}, &buf
+}
+
+func TestDefaultLoggerDiscardsMessagesBelowLevel(t *testing.T) {
+	logger, buf := newBufferedLogger(LogLevelInfo)
+
+	logger.Debug("debug message")
+	if buf.Len() != 0 {
+		t.Fatalf("debug message written at info level: %q", buf.String())
+	}
+}
+
+func TestDefaultLoggerWritesMessagesAtOrAboveLevel(t *testing.T) {
+	logger, buf := newBufferedLogger(LogLevelWarn)
+
+	logger.Info("info message")
+	logger.Warn("warn message")
+	logger.Error("error message")
+
+	got := buf.String()
+	if strings.Contains(got, "info message") {
+		t.Errorf("info message written at warn level: %q", got)
+	}
+	if !strings.Contains(got, "WARN: warn message") {
+		t.Errorf("warn message not written at warn level: %q", got)
+	}
+	if !strings.Contains(got, "ERROR: error message") {
+		t.Errorf("error message not written at warn level: %q", got)
+	}
+}
@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// noopLogger implements the Logger interface by discarding all messages.
+// Fatal and Panic messages still exit the program and panic, respectively.
+type noopLogger struct{}
+
+// NewNoop returns a logger that silently discards all messages.
+// It is useful as a safe default in tests and libraries that do not want to produce output.
+func NewNoop() Logger {
+	return &noopLogger{}
+}
+
+// Debug discards a debug-level message.
+func (inst *noopLogger) Debug(msg string) {}
+
+// Info discards an info-level message.
+func (inst *noopLogger) Info(msg string) {}
+
+// Warn discards a warning-level message.
+func (inst *noopLogger) Warn(msg string) {}
+
+// Error discards an error-level message.
+func (inst *noopLogger) Error(msg string) {}
+
+// Fatal discards a fatal-level message and exits the application.
+func (inst *noopLogger) Fatal(msg string) {
+	os.Exit(1)
+}
+
+// Panic discards a panic-level message and panics.
+func (inst *noopLogger) Panic(msg string) {
+	panic(msg)
+}
+
+// Debugf discards a formatted debug-level message.
+func (inst *noopLogger) Debugf(format string, args ...interface{}) {}
+
+// Infof discards a formatted info-level message.
+func (inst *noopLogger) Infof(format string, args ...interface{}) {}
+
+// Warnf discards a formatted warning-level message.
+func (inst *noopLogger) Warnf(format string, args ...interface{}) {}
+
+// Errorf discards a formatted error-level message.
+func (inst *noopLogger) Errorf(format string, args ...interface{}) {}
+
+// Fatalf discards a formatted fatal-level message and exits the application.
+func (inst *noopLogger) Fatalf(format string, args ...interface{}) {
+	os.Exit(1)
+}
+
+// Panicf discards a formatted panic-level message and panics.
+func (inst *noopLogger) Panicf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns the same logger, as fields are discarded along with the messages.
+func (inst *noopLogger) WithFields(fields map[string]interface{}) Logger {
+	return inst
+}
+
+// WithError returns the same logger, as errors are discarded along with the messages.
+func (inst *noopLogger) WithError(err error) Logger {
+	return inst
+}
+
+// WithContext returns the same logger, as context values are discarded along with the messages.
+func (inst *noopLogger) WithContext(ctx context.Context) Logger {
+	return inst
+}
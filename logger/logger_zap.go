@@ -1,7 +1,10 @@
 package logger
 
 import (
+	"context"
+
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // zapLogger implements the Logger interface using the Zap logging library.
@@ -10,14 +13,20 @@ type zapLogger struct {
 }
 
 // newZapLogger initializes a Zap logger based on the provided configuration.
-// It sets the log level, output location, and format, defaulting to JSON format
-// and stdout output if none are provided.
+// It sets the log level, output location, and format, defaulting to plain console format
+// and stdout output if none are provided. When rotation limits are configured, the log file is rotated accordingly.
+// The format only selects the encoding; the remaining settings are the same production defaults for both formats,
+// except that sampling is only enabled when configured.
 func newZapLogger(conf Config) Logger {
-	var zapCfg zap.Config
+	zapCfg := zap.NewProductionConfig()
+	zapCfg.Sampling = nil
+
+	// Select the encoding based on the configured format.
 	if conf.Format == LogFormatJSON {
-		zapCfg = zap.NewProductionConfig()
+		zapCfg.Encoding = "json"
 	} else {
-		zapCfg = zap.NewDevelopmentConfig()
+		zapCfg.Encoding = "console"
+		zapCfg.EncoderConfig = zap.NewDevelopmentEncoderConfig()
 	}
 
 	// Set log level based on configuration.
@@ -36,8 +45,31 @@ func newZapLogger(conf Config) Logger {
 		zapCfg.Level.SetLevel(zap.InfoLevel)
 	}
 
+	// Enable sampling if configured.
+	if conf.isSamplingEnabled() {
+		zapCfg.Sampling = &zap.SamplingConfig{
+			Initial:    conf.SampleInitial,
+			Thereafter: conf.SampleThereafter,
+		}
+	}
+
+	// Write to a rotating log file if rotation is configured.
+	if conf.isRotationEnabled() {
+		encoder := zapcore.NewConsoleEncoder(zapCfg.EncoderConfig)
+		if zapCfg.Encoding == "json" {
+			encoder = zapcore.NewJSONEncoder(zapCfg.EncoderConfig)
+		}
+		core := zapcore.NewCore(encoder, zapcore.AddSync(newRotatingWriter(conf)), zapCfg.Level)
+		if zapCfg.Sampling != nil {
+			core = zapcore.NewSamplerWithOptions(core, sampleInterval, zapCfg.Sampling.Initial, zapCfg.Sampling.Thereafter)
+		}
+		// Build from the configuration so the rotated logger gets the same stacktrace and error output options.
+		logger, _ := zapCfg.Build(zap.WrapCore(func(zapcore.Core) zapcore.Core { return core }), zap.AddCaller(), zap.AddCallerSkip(1))
+		return &zapLogger{logger: logger}
+	}
+
 	// Set output location if specified.
-	if conf.Output != LogOutputStdout && conf.Output != "" {
+	if conf.isFileOutput() {
 		zapCfg.OutputPaths = []string{string(conf.Output)}
 	}
 
@@ -104,3 +136,22 @@ func (inst *zapLogger) Fatalf(format string, args ...interface{}) {
 func (inst *zapLogger) Panicf(format string, args ...interface{}) {
 	inst.logger.Sugar().Panicf(format, args...)
 }
+
+// WithFields returns a child logger that attaches the given fields as structured Zap fields.
+func (inst *zapLogger) WithFields(fields map[string]interface{}) Logger {
+	zapFields := make([]zap.Field, 0, len(fields))
+	for key, value := range fields {
+		zapFields = append(zapFields, zap.Any(key, value))
+	}
+	return &zapLogger{logger: inst.logger.With(zapFields...)}
+}
+
+// WithError returns a child logger that attaches the error as a structured Zap error field.
+func (inst *zapLogger) WithError(err error) Logger {
+	return &zapLogger{logger: inst.logger.With(zap.NamedError(FieldError, err))}
+}
+
+// WithContext returns a child logger that attaches the trace ID and request ID stored in the context.
+func (inst *zapLogger) WithContext(ctx context.Context) Logger {
+	return inst.WithFields(contextFields(ctx))
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestZapLoggerRotatedFileIncludesStacktraceOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	logger := newZapLogger(Config{Output: LogOutput(path), Format: LogFormatJSON, MaxSize: 1})
+
+	logger.Error("error message")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log file: %v", err)
+	}
+	if got := string(data); !strings.Contains(got, `"stacktrace"`) {
+		t.Fatalf("error message logged without stacktrace: %s", got)
+	}
+}
+
+func TestZapLoggerDoesNotSampleByDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	logger := newZapLogger(Config{Output: LogOutput(path), Format: LogFormatPlain})
+
+	// Exceed the production sampling limit of 100 identical messages per second.
+	const total = 150
+	for i := 0; i < total; i++ {
+		logger.Info("info message")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log file: %v", err)
+	}
+	if got := strings.Count(string(data), "info message"); got != total {
+		t.Fatalf("got %d messages, want %d", got, total)
+	}
+}
@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+// sampleInterval is the period over which identical messages are counted for sampling.
+const sampleInterval = time.Second
+
+// sampler limits how often identical messages are written, mirroring Zap's sampling strategy:
+// within each interval, the first initial occurrences of a message are written, then every thereafter-th one.
+// It is safe for concurrent use.
+type sampler struct {
+	initial    int            // Number of identical messages written per interval before sampling starts.
+	thereafter int            // After the initial messages, every thereafter-th message is written; 0 drops them all.
+	mu         sync.Mutex     // Guards the fields below.
+	resetAt    time.Time      // Time at which the counts are reset.
+	counts     map[string]int // Occurrences of each message in the current interval.
+}
+
+// newSampler creates a sampler with the given initial and thereafter counts.
+func newSampler(initial, thereafter int) *sampler {
+	return &sampler{
+		initial:    initial,
+		thereafter: thereafter,
+		counts:     make(map[string]int),
+	}
+}
+
+// allow records an occurrence of the message identified by key and reports whether it should be written.
+func (inst *sampler) allow(key string) bool {
+	inst.mu.Lock()
+	defer inst.mu.Unlock()
+
+	// Start a new interval once the current one has elapsed.
+	now := time.Now()
+	if now.After(inst.resetAt) {
+		inst.counts = make(map[string]int)
+		inst.resetAt = now.Add(sampleInterval)
+	}
+
+	inst.counts[key]++
+	n := inst.counts[key]
+	if n <= inst.initial {
+		return true
+	}
+	return inst.thereafter > 0 && (n-inst.initial)%inst.thereafter == 0
+}
@@ -0,0 +1,11 @@
+package metrics
+
+import "time"
+
+// Collector defines the interface for recording metrics about operations performed by the Service wrappers.
+// Each Service invokes it once per operation when configured with one. Implementations must be safe for concurrent use.
+type Collector interface {
+	// Observe records a completed operation of the given system (e.g. "redis", "mongo"),
+	// its name (e.g. the command name), how long it took and the error it failed with, if any.
+	Observe(system, operation string, duration time.Duration, err error)
+}
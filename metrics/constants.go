@@ -0,0 +1,19 @@
+package metrics
+
+// Systems reported by the Service wrappers to a Collector.
+const (
+	// SystemRedis identifies operations performed by the redis package.
+	SystemRedis = "redis"
+
+	// SystemMongo identifies operations performed by the mongo package.
+	SystemMongo = "mongo"
+
+	// SystemMinio identifies operations performed by the minio package.
+	SystemMinio = "minio"
+
+	// SystemElastic identifies operations performed by the elastic package.
+	SystemElastic = "elastic"
+)
+
+// DefaultNamespace is the default Prometheus namespace for the metrics of a PrometheusCollector.
+const DefaultNamespace = "shared"
@@ -0,0 +1,10 @@
+package metrics
+
+// Error messages for the metrics package.
+const (
+	// ErrUnexpectedStatus is recorded when an HTTP request completes with a server error status.
+	ErrUnexpectedStatus = "unexpected status code %d"
+
+	// ErrRegisteringCollector is returned when the Prometheus metrics cannot be registered.
+	ErrRegisteringCollector = "failed to register Prometheus metrics: %w"
+)
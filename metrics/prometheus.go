@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusCollector is a Collector exporting operation latencies and error counts as Prometheus metrics.
+// Both metrics are labeled by system and operation.
+type PrometheusCollector struct {
+	duration *prometheus.HistogramVec // Operation latencies, in seconds.
+	errors   *prometheus.CounterVec   // Number of failed operations.
+}
+
+// NewPrometheusCollector creates a PrometheusCollector and registers its metrics with the given registerer.
+// If namespace is empty, DefaultNamespace is used. If registerer is nil, prometheus.DefaultRegisterer is used.
+// It returns an error if the metrics cannot be registered, e.g. when they are already registered.
+func NewPrometheusCollector(namespace string, registerer prometheus.Registerer) (*PrometheusCollector, error) {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	collector := &PrometheusCollector{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of operations performed against backing services.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"system", "operation"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "operation_errors_total",
+			Help:      "Number of failed operations performed against backing services.",
+		}, []string{"system", "operation"}),
+	}
+
+	// Register the metrics.
+	for _, c := range []prometheus.Collector{collector.duration, collector.errors} {
+		if err := registerer.Register(c); err != nil {
+			return nil, fmt.Errorf(ErrRegisteringCollector, err)
+		}
+	}
+
+	return collector, nil
+}
+
+// Observe records the duration of the operation and, if it failed, increments its error count.
+func (inst *PrometheusCollector) Observe(system, operation string, duration time.Duration, err error) {
+	inst.duration.WithLabelValues(system, operation).Observe(duration.Seconds())
+	if err != nil {
+		inst.errors.WithLabelValues(system, operation).Inc()
+	}
+}
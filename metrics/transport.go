@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// transport is an http.RoundTripper recording each request as an operation named by the operation function,
+// or after its HTTP method if there is none.
+type transport struct {
+	system    string
+	next      http.RoundTripper
+	collector Collector
+	operation func(*http.Request) string
+}
+
+// NewTransport wraps the given http.RoundTripper so that each request is recorded by the collector,
+// as an operation named after its HTTP method.
+// Requests failing at the transport level or with a 5xx status are recorded with an error.
+// If the collector is nil, the given RoundTripper is returned unchanged.
+func NewTransport(system string, next http.RoundTripper, collector Collector) http.RoundTripper {
+	return NewOperationTransport(system, next, collector, nil)
+}
+
+// NewOperationTransport is like NewTransport, but names the operation of each request with the given function,
+// e.g. to tell apart the API calls of a service sharing the same HTTP method. A nil function names it after the HTTP method.
+func NewOperationTransport(system string, next http.RoundTripper, collector Collector, operation func(*http.Request) string) http.RoundTripper {
+	if collector == nil {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &transport{system: system, next: next, collector: collector, operation: operation}
+}
+
+// RoundTrip executes the request with the wrapped RoundTripper and records it.
+func (inst *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := inst.next.RoundTrip(req)
+
+	observed := err
+	if observed == nil && res.StatusCode >= http.StatusInternalServerError {
+		observed = fmt.Errorf(ErrUnexpectedStatus, res.StatusCode)
+	}
+	operation := req.Method
+	if inst.operation != nil {
+		operation = inst.operation(req)
+	}
+	inst.collector.Observe(inst.system, operation, time.Since(start), observed)
+
+	return res, err
+}
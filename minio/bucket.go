@@ -0,0 +1,145 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// SetBucketPolicy applies the given access policy, expressed as a JSON document, to the specified bucket.
+// An empty policy removes the existing bucket policy. It uses the timeout from the Service struct.
+func (inst *Service) SetBucketPolicy(bucketName, policyJSON string) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Apply the policy to the bucket.
+	err := inst.client.SetBucketPolicy(ctx, bucketName, policyJSON)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToSetBucketPolicy, bucketName, err)
+	}
+
+	return nil
+}
+
+// GetBucketPolicy retrieves the access policy of the specified bucket as a JSON document.
+// It returns an empty string if no policy is set and uses the timeout from the Service struct.
+func (inst *Service) GetBucketPolicy(bucketName string) (string, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Retrieve the bucket policy.
+	policy, err := inst.client.GetBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return "", fmt.Errorf(ErrFailedToGetBucketPolicy, bucketName, err)
+	}
+
+	return policy, nil
+}
+
+// SetBucketLifecycle applies the given lifecycle configuration, such as expiration rules, to the specified bucket.
+// It uses the timeout from the Service struct.
+func (inst *Service) SetBucketLifecycle(bucketName string, config *lifecycle.Configuration) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Apply the lifecycle configuration to the bucket.
+	err := inst.client.SetBucketLifecycle(ctx, bucketName, config)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToSetBucketLifecycle, bucketName, err)
+	}
+
+	return nil
+}
+
+// GetBucketLifecycle retrieves the lifecycle configuration of the specified bucket.
+// It uses the timeout from the Service struct.
+func (inst *Service) GetBucketLifecycle(bucketName string) (*lifecycle.Configuration, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Retrieve the lifecycle configuration.
+	config, err := inst.client.GetBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf(ErrFailedToGetBucketLifecycle, bucketName, err)
+	}
+
+	return config, nil
+}
+
+// RemoveBucket deletes the specified bucket, which must be empty.
+// Use ForceRemoveBucket to delete a bucket along with its content. It uses the timeout from the Service struct.
+func (inst *Service) RemoveBucket(bucketName string) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Remove the bucket.
+	err := inst.client.RemoveBucket(ctx, bucketName)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToRemoveBucket, bucketName, err)
+	}
+
+	return nil
+}
+
+// EmptyBucket deletes all objects in the specified bucket, including every object version and incomplete multipart uploads.
+// Objects are removed in batched requests. The Service timeout is not applied, since large buckets may take arbitrarily long.
+func (inst *Service) EmptyBucket(bucketName string) error {
+	// Create a cancelable context that stops the listing if the removal fails.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Feed every object version of the bucket to the batch removal, stopping at the first listing error.
+	var listErr error
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for object := range inst.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Recursive: true, WithVersions: true}) {
+			if object.Err != nil {
+				listErr = object.Err
+				return
+			}
+			select {
+			case objectsCh <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Remove the objects; the listing error is safe to read once the removal has drained the channel.
+	if err := inst.removeObjects(ctx, bucketName, objectsCh); err != nil {
+		return fmt.Errorf(ErrFailedToEmptyBucket, bucketName, err)
+	}
+	if listErr != nil {
+		return fmt.Errorf(ErrFailedToEmptyBucket, bucketName, fmt.Errorf(ErrFailedToListObjects, bucketName, listErr))
+	}
+
+	// Remove the incomplete multipart uploads.
+	for upload := range inst.client.ListIncompleteUploads(ctx, bucketName, "", true) {
+		if upload.Err != nil {
+			return fmt.Errorf(ErrFailedToEmptyBucket, bucketName, fmt.Errorf(ErrFailedToListIncompleteUploads, bucketName, upload.Err))
+		}
+		if err := inst.client.RemoveIncompleteUpload(ctx, bucketName, upload.Key); err != nil {
+			return fmt.Errorf(ErrFailedToEmptyBucket, bucketName, fmt.Errorf(ErrFailedToAbortMultipartUpload, upload.UploadID, err))
+		}
+	}
+
+	return nil
+}
+
+// ForceRemoveBucket deletes the specified bucket along with all its content, emptying it first with EmptyBucket.
+func (inst *Service) ForceRemoveBucket(bucketName string) error {
+	if err := inst.EmptyBucket(bucketName); err != nil {
+		return err
+	}
+
+	return inst.RemoveBucket(bucketName)
+}
@@ -1,5 +1,10 @@
 package minio
 
+import (
+	"github.com/nguyendang2000/shared-go/metrics"
+	"go.opentelemetry.io/otel/trace"
+)
+
 // Config represents the configuration settings required for connecting to a MinIO server.
 type Config struct {
 	// Address specifies the MinIO server address (e.g., play.min.io).
@@ -18,4 +23,21 @@ type Config struct {
 	// Timeout defines the number of seconds before a request to the MinIO server times out.
 	// This field is optional.
 	Timeout int64 `yaml:"timeout"`
+
+	// ConnectAttempts makes NewService verify that the MinIO server is reachable, trying up to this many times
+	// before returning an error, so a service can start before MinIO is ready.
+	// This field is optional; by default NewService does not contact the server.
+	ConnectAttempts int `yaml:"connect_attempts"`
+
+	// ConnectBackoff defines the number of seconds before retrying to reach the MinIO server.
+	// The delay doubles after each failed attempt. This field is optional and defaults to 1 second.
+	ConnectBackoff int64 `yaml:"connect_backoff"`
+
+	// MetricsCollector optionally records the latency and outcome of every request sent to MinIO,
+	// named after the S3 operation (e.g. "GetObject", "ListObjects", "StatObject"). Metrics are disabled if nil.
+	MetricsCollector metrics.Collector `yaml:"-"`
+
+	// TracerProvider optionally traces every request sent to MinIO in its own span. The Service methods do not
+	// take a context, so the spans are roots and do not join the caller's trace. Tracing is disabled if nil.
+	TracerProvider trace.TracerProvider `yaml:"-"`
 }
@@ -2,3 +2,10 @@ package minio
 
 // DefaultTimeout defines the default request timeout in seconds
 const DefaultTimeout int64 = 30 // 30 seconds
+
+// DefaultConnectBackoff defines the default delay in seconds before retrying to reach the server in NewService
+const DefaultConnectBackoff int64 = 1 // 1 second
+
+// DefaultPartSize defines the default multipart part size in bytes used when streaming
+// uploads of unknown length.
+const DefaultPartSize uint64 = 16 * 1024 * 1024 // 16 MiB
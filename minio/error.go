@@ -1,28 +1,74 @@
 package minio
 
 // Error constants for the minio package.
+// Each constant wraps the underlying MinIO error with %w so it can be inspected with errors.Is and errors.As.
 const (
 	// ErrFailedToInitializeClient represents an error when the MinIO client initialization fails.
-	ErrFailedToInitializeClient = "failed to initialize MinIO client: %v"
+	ErrFailedToInitializeClient = "failed to initialize MinIO client: %w"
 
 	// ErrFailedToGetObject represents an error when fetching an object from a bucket fails.
-	ErrFailedToGetObject = "failed to get object from bucket %s: %v"
+	ErrFailedToGetObject = "failed to get object from bucket %s: %w"
 
 	// ErrFailedToReadObject represents an error when reading from the fetched object fails.
-	ErrFailedToReadObject = "failed to read object %s: %v"
+	ErrFailedToReadObject = "failed to read object %s: %w"
 
 	// ErrFailedToPutObject represents an error when uploading an object to a bucket fails.
-	ErrFailedToPutObject = "failed to put object in bucket %s: %v"
+	ErrFailedToPutObject = "failed to put object in bucket %s: %w"
 
 	// ErrFailedToCopyObject represents an error when copying an object between buckets fails.
-	ErrFailedToCopyObject = "failed to copy object from %s/%s to %s/%s: %v"
+	ErrFailedToCopyObject = "failed to copy object from %s/%s to %s/%s: %w"
 
 	// ErrFailedToStatObject represents an error when retrieving object metadata fails.
-	ErrFailedToStatObject = "failed to stat object %s in bucket %s: %v"
+	ErrFailedToStatObject = "failed to stat object %s in bucket %s: %w"
 
 	// ErrFailedToRemoveObject represents an error when deleting a single object fails.
-	ErrFailedToRemoveObject = "failed to remove object %s from bucket %s: %v"
+	ErrFailedToRemoveObject = "failed to remove object %s from bucket %s: %w"
+
+	// ErrFailedToCheckObjectExistence represents an error when checking whether an object exists fails.
+	ErrFailedToCheckObjectExistence = "failed to check if object %s exists in bucket %s: %w"
+
+	// ErrFailedToListObjects represents an error when listing objects in a bucket fails.
+	ErrFailedToListObjects = "failed to list objects in bucket %s: %w"
+
+	// ErrFailedToSetBucketPolicy represents an error when setting a bucket policy fails.
+	ErrFailedToSetBucketPolicy = "failed to set policy for bucket %s: %w"
+
+	// ErrFailedToGetBucketPolicy represents an error when retrieving a bucket policy fails.
+	ErrFailedToGetBucketPolicy = "failed to get policy for bucket %s: %w"
+
+	// ErrFailedToSetBucketLifecycle represents an error when setting a bucket lifecycle configuration fails.
+	ErrFailedToSetBucketLifecycle = "failed to set lifecycle for bucket %s: %w"
+
+	// ErrFailedToGetBucketLifecycle represents an error when retrieving a bucket lifecycle configuration fails.
+	ErrFailedToGetBucketLifecycle = "failed to get lifecycle for bucket %s: %w"
+
+	// ErrFailedToListIncompleteUploads represents an error when listing incomplete uploads in a bucket fails.
+	ErrFailedToListIncompleteUploads = "failed to list incomplete uploads in bucket %s: %w"
+
+	// ErrFailedToCreateMultipartUpload represents an error when initiating a multipart upload fails.
+	ErrFailedToCreateMultipartUpload = "failed to create multipart upload for object %s in bucket %s: %w"
+
+	// ErrFailedToUploadPart represents an error when uploading a part of a multipart upload fails.
+	ErrFailedToUploadPart = "failed to upload part %d of upload %s: %w"
+
+	// ErrFailedToListParts represents an error when listing the uploaded parts of a multipart upload fails.
+	ErrFailedToListParts = "failed to list parts of upload %s: %w"
+
+	// ErrFailedToCompleteMultipartUpload represents an error when completing a multipart upload fails.
+	ErrFailedToCompleteMultipartUpload = "failed to complete multipart upload %s: %w"
+
+	// ErrFailedToAbortMultipartUpload represents an error when aborting a multipart upload fails.
+	ErrFailedToAbortMultipartUpload = "failed to abort multipart upload %s: %w"
+
+	// ErrFailedToComposeObject represents an error when composing an object from source objects fails.
+	ErrFailedToComposeObject = "failed to compose object %s in bucket %s: %w"
+
+	// ErrFailedToRemoveBucket represents an error when deleting a bucket fails.
+	ErrFailedToRemoveBucket = "failed to remove bucket %s: %w"
+
+	// ErrFailedToEmptyBucket represents an error when deleting the content of a bucket fails.
+	ErrFailedToEmptyBucket = "failed to empty bucket %s: %w"
 
 	// ErrFailedToConnect represents an error when connecting to MinIO fails.
-	ErrFailedToConnect = "failed to connect to MinIO: %v"
+	ErrFailedToConnect = "failed to connect to MinIO: %w"
 )
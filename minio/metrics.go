@@ -0,0 +1,139 @@
+package minio
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// bucketSubresources maps the query parameters selecting a bucket configuration to the name used in operation names.
+var bucketSubresources = map[string]string{
+	"policy":       "Policy",
+	"lifecycle":    "Lifecycle",
+	"versioning":   "Versioning",
+	"tagging":      "Tagging",
+	"notification": "Notification",
+	"encryption":   "Encryption",
+	"replication":  "Replication",
+	"object-lock":  "ObjectLockConfig",
+}
+
+// newOperationNamer returns a function naming the S3 operation of a request sent to the given endpoint
+// after the matching client method (e.g. "GetObject", "ListObjects", "StatObject"), so operations sharing
+// an HTTP method can be told apart. Requests that cannot be identified are named after their HTTP method.
+func newOperationNamer(endpoint string) func(*http.Request) string {
+	return func(req *http.Request) string {
+		// Virtual-host-style requests carry the bucket in the host, path-style requests in the first path segment.
+		path := strings.TrimPrefix(req.URL.Path, "/")
+		hasBucket, hasObject := path != "", strings.Contains(strings.TrimSuffix(path, "/"), "/")
+		if strings.HasSuffix(req.URL.Host, "."+endpoint) {
+			hasBucket, hasObject = true, path != ""
+		}
+
+		query := req.URL.Query()
+		switch {
+		case hasObject:
+			return objectOperation(req, query)
+		case hasBucket:
+			return bucketOperation(req.Method, query)
+		case req.Method == http.MethodGet:
+			return "ListBuckets"
+		default:
+			return req.Method
+		}
+	}
+}
+
+// objectOperation names a request addressing an object.
+func objectOperation(req *http.Request, query url.Values) string {
+	_, uploadID := query["uploadId"]
+	_, tagging := query["tagging"]
+	copySource := req.Header.Get("X-Amz-Copy-Source") != ""
+
+	switch req.Method {
+	case http.MethodGet:
+		if uploadID {
+			return "ListObjectParts"
+		}
+		if tagging {
+			return "GetObjectTagging"
+		}
+		return "GetObject"
+	case http.MethodHead:
+		return "StatObject"
+	case http.MethodPut:
+		if uploadID && copySource {
+			return "CopyObjectPart"
+		}
+		if uploadID {
+			return "PutObjectPart"
+		}
+		if tagging {
+			return "PutObjectTagging"
+		}
+		if copySource {
+			return "CopyObject"
+		}
+		return "PutObject"
+	case http.MethodPost:
+		if _, ok := query["uploads"]; ok {
+			return "NewMultipartUpload"
+		}
+		if uploadID {
+			return "CompleteMultipartUpload"
+		}
+	case http.MethodDelete:
+		if uploadID {
+			return "AbortMultipartUpload"
+		}
+		if tagging {
+			return "RemoveObjectTagging"
+		}
+		return "RemoveObject"
+	}
+
+	return req.Method
+}
+
+// bucketOperation names a request addressing a bucket.
+func bucketOperation(method string, query url.Values) string {
+	// Requests on a bucket configuration are named after the configuration, e.g. "GetBucketPolicy".
+	for param, name := range bucketSubresources {
+		if _, ok := query[param]; ok {
+			switch method {
+			case http.MethodGet:
+				return "GetBucket" + name
+			case http.MethodPut:
+				return "SetBucket" + name
+			case http.MethodDelete:
+				return "RemoveBucket" + name
+			}
+		}
+	}
+
+	switch method {
+	case http.MethodGet:
+		if _, ok := query["location"]; ok {
+			return "GetBucketLocation"
+		}
+		if _, ok := query["uploads"]; ok {
+			return "ListIncompleteUploads"
+		}
+		if _, ok := query["versions"]; ok {
+			return "ListObjectVersions"
+		}
+		return "ListObjects"
+	case http.MethodHead:
+		return "BucketExists"
+	case http.MethodPut:
+		return "MakeBucket"
+	case http.MethodPost:
+		if _, ok := query["delete"]; ok {
+			return "RemoveObjects"
+		}
+	case http.MethodDelete:
+		return "RemoveBucket"
+	}
+
+	return method
+}
@@ -0,0 +1,45 @@
+package minio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOperationNamerTellsOperationsApart(t *testing.T) {
+	name := newOperationNamer("minio.local:9000")
+
+	tests := []struct {
+		method, url, copySource, want string
+	}{
+		{http.MethodGet, "http://minio.local:9000/", "", "ListBuckets"},
+		{http.MethodGet, "http://minio.local:9000/photos/?list-type=2&prefix=2024", "", "ListObjects"},
+		{http.MethodGet, "http://minio.local:9000/photos/?location=", "", "GetBucketLocation"},
+		{http.MethodGet, "http://minio.local:9000/photos/?policy=", "", "GetBucketPolicy"},
+		{http.MethodPut, "http://minio.local:9000/photos/?lifecycle=", "", "SetBucketLifecycle"},
+		{http.MethodHead, "http://minio.local:9000/photos/", "", "BucketExists"},
+		{http.MethodGet, "http://minio.local:9000/photos/2024/cat.jpg", "", "GetObject"},
+		{http.MethodHead, "http://minio.local:9000/photos/2024/cat.jpg", "", "StatObject"},
+		{http.MethodPut, "http://minio.local:9000/photos/cat.jpg", "", "PutObject"},
+		{http.MethodPut, "http://minio.local:9000/photos/cat.jpg", "/photos/dog.jpg", "CopyObject"},
+		{http.MethodPut, "http://minio.local:9000/photos/cat.jpg?partNumber=1&uploadId=u1", "", "PutObjectPart"},
+		{http.MethodPost, "http://minio.local:9000/photos/cat.jpg?uploads=", "", "NewMultipartUpload"},
+		{http.MethodPost, "http://minio.local:9000/photos/cat.jpg?uploadId=u1", "", "CompleteMultipartUpload"},
+		{http.MethodDelete, "http://minio.local:9000/photos/cat.jpg?uploadId=u1", "", "AbortMultipartUpload"},
+		{http.MethodPost, "http://minio.local:9000/photos/?delete=", "", "RemoveObjects"},
+		{http.MethodDelete, "http://minio.local:9000/photos/cat.jpg", "", "RemoveObject"},
+		// Virtual-host-style requests carry the bucket in the host.
+		{http.MethodGet, "http://photos.minio.local:9000/", "", "ListObjects"},
+		{http.MethodGet, "http://photos.minio.local:9000/cat.jpg", "", "GetObject"},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, test.url, nil)
+		if test.copySource != "" {
+			req.Header.Set("X-Amz-Copy-Source", test.copySource)
+		}
+		if got := name(req); got != test.want {
+			t.Errorf("%s %s: got %s, want %s", test.method, test.url, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ListIncompleteUploads lists the multipart uploads in the specified bucket that were started but never completed,
+// for objects whose names start with the given prefix. The returned entries include the upload ID and size,
+// allowing an interrupted upload to be resumed. It uses the timeout from the Service struct.
+func (inst *Service) ListIncompleteUploads(bucketName, prefix string, recursive bool) ([]minio.ObjectMultipartInfo, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Collect the uploads streamed by MinIO, stopping at the first error.
+	var uploads []minio.ObjectMultipartInfo
+	for upload := range inst.client.ListIncompleteUploads(ctx, bucketName, prefix, recursive) {
+		if upload.Err != nil {
+			return nil, fmt.Errorf(ErrFailedToListIncompleteUploads, bucketName, upload.Err)
+		}
+		uploads = append(uploads, upload)
+	}
+
+	return uploads, nil
+}
+
+// NewMultipartUpload initiates a multipart upload for the specified object and returns its upload ID.
+// It accepts a pointer to minio.PutObjectOptions for additional options and uses the timeout from the Service struct.
+func (inst *Service) NewMultipartUpload(bucketName, objectName string, opts *minio.PutObjectOptions) (string, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// If opts is nil, initialize an empty minio.PutObjectOptions struct.
+	if opts == nil {
+		opts = &minio.PutObjectOptions{}
+	}
+
+	// Initiate the multipart upload.
+	uploadID, err := inst.core().NewMultipartUpload(ctx, bucketName, objectName, *opts)
+	if err != nil {
+		return "", fmt.Errorf(ErrFailedToCreateMultipartUpload, objectName, bucketName, err)
+	}
+
+	return uploadID, nil
+}
+
+// UploadPart uploads a single part of a multipart upload and returns the uploaded part,
+// which must be passed to CompleteMultipartUpload. Part numbers start at 1.
+// It accepts a pointer to minio.PutObjectPartOptions for additional options, such as the ServerSideEncryption key
+// required for every part of an upload started with SSE-C. The Service timeout is not applied, since a part may be arbitrarily large.
+func (inst *Service) UploadPart(bucketName, objectName, uploadID string, partNumber int, reader io.Reader, partSize int64, opts *minio.PutObjectPartOptions) (minio.ObjectPart, error) {
+	// If opts is nil, initialize an empty minio.PutObjectPartOptions struct.
+	if opts == nil {
+		opts = &minio.PutObjectPartOptions{}
+	}
+
+	// Upload the part.
+	part, err := inst.core().PutObjectPart(context.Background(), bucketName, objectName, uploadID, partNumber, reader, partSize, *opts)
+	if err != nil {
+		return minio.ObjectPart{}, fmt.Errorf(ErrFailedToUploadPart, partNumber, uploadID, err)
+	}
+
+	return part, nil
+}
+
+// ListUploadedParts lists the parts already uploaded for a multipart upload,
+// allowing the caller to resume the upload from the first missing part.
+// It uses the timeout from the Service struct.
+func (inst *Service) ListUploadedParts(bucketName, objectName, uploadID string) ([]minio.ObjectPart, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Fetch the parts page by page until the listing is no longer truncated.
+	var parts []minio.ObjectPart
+	marker := 0
+	for {
+		result, err := inst.core().ListObjectParts(ctx, bucketName, objectName, uploadID, marker, 0)
+		if err != nil {
+			return nil, fmt.Errorf(ErrFailedToListParts, uploadID, err)
+		}
+		parts = append(parts, result.ObjectParts...)
+
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextPartNumberMarker
+	}
+
+	return parts, nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the final object.
+// The parts must be ordered by part number. It uses the timeout from the Service struct.
+func (inst *Service) CompleteMultipartUpload(bucketName, objectName, uploadID string, parts []minio.ObjectPart) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Convert the uploaded parts into completion entries.
+	completeParts := make([]minio.CompletePart, len(parts))
+	for i, part := range parts {
+		completeParts[i] = minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		}
+	}
+
+	// Complete the multipart upload.
+	_, err := inst.core().CompleteMultipartUpload(ctx, bucketName, objectName, uploadID, completeParts, minio.PutObjectOptions{})
+	if err != nil {
+		return fmt.Errorf(ErrFailedToCompleteMultipartUpload, uploadID, err)
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload aborts a multipart upload and removes its uploaded parts.
+// It uses the timeout from the Service struct.
+func (inst *Service) AbortMultipartUpload(bucketName, objectName, uploadID string) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Abort the multipart upload.
+	err := inst.core().AbortMultipartUpload(ctx, bucketName, objectName, uploadID)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToAbortMultipartUpload, uploadID, err)
+	}
+
+	return nil
+}
+
+// ComposeObject creates an object by concatenating existing source objects from the same bucket, in order.
+// The composition happens server-side, and all sources except the last must be at least 5 MiB.
+// It uses the timeout from the Service struct.
+func (inst *Service) ComposeObject(bucketName, objectName string, sourceObjects ...string) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Set up the source and destination options.
+	srcOpts := make([]minio.CopySrcOptions, len(sourceObjects))
+	for i, sourceObject := range sourceObjects {
+		srcOpts[i] = minio.CopySrcOptions{
+			Bucket: bucketName,
+			Object: sourceObject,
+		}
+	}
+	destOpts := minio.CopyDestOptions{
+		Bucket: bucketName,
+		Object: objectName,
+	}
+
+	// Perform the server-side composition.
+	_, err := inst.client.ComposeObject(ctx, destOpts, srcOpts...)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToComposeObject, objectName, bucketName, err)
+	}
+
+	return nil
+}
@@ -11,14 +11,26 @@ import (
 
 // GetObject retrieves an object from the specified bucket using the provided object name.
 // It returns the object as a byte array, allowing for further processing.
-// It uses the timeout from the Service struct.
+// The whole object is loaded into memory, so it should only be used for small objects;
+// use GetObjectStream for large files. It uses the timeout from the Service struct.
 func (inst *Service) GetObject(bucketName, objectName string) ([]byte, error) {
+	return inst.GetObjectWithOptions(bucketName, objectName, nil)
+}
+
+// GetObjectWithOptions retrieves an object like GetObject, accepting a pointer to minio.GetObjectOptions
+// for additional options, such as the ServerSideEncryption key required to read SSE-C encrypted objects.
+func (inst *Service) GetObjectWithOptions(bucketName, objectName string, opts *minio.GetObjectOptions) ([]byte, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
+	// If opts is nil, initialize an empty minio.GetObjectOptions struct.
+	if opts == nil {
+		opts = &minio.GetObjectOptions{}
+	}
+
 	// Use MinIO's GetObject method to retrieve the object.
-	object, err := inst.client.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	object, err := inst.client.GetObject(ctx, bucketName, objectName, *opts)
 	if err != nil {
 		return nil, fmt.Errorf(ErrFailedToGetObject, bucketName, err)
 	}
@@ -32,15 +44,68 @@ func (inst *Service) GetObject(bucketName, objectName string) ([]byte, error) {
 	return data, nil
 }
 
+// objectStream wraps a MinIO object so that closing it also releases its context.
+type objectStream struct {
+	*minio.Object
+	cancel context.CancelFunc
+}
+
+// Close closes the underlying object and cancels its context.
+func (inst *objectStream) Close() error {
+	defer inst.cancel()
+	return inst.Object.Close()
+}
+
+// GetObjectStream retrieves an object from the specified bucket and returns a reader over its content,
+// allowing callers to stream large objects without loading them into memory.
+// It accepts a pointer to minio.GetObjectOptions for additional options.
+// The Service timeout is not applied, since reading may take arbitrarily long; the caller must close the reader.
+func (inst *Service) GetObjectStream(bucketName, objectName string, opts *minio.GetObjectOptions) (io.ReadCloser, error) {
+	// Create a cancelable context that lives as long as the returned reader.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// If opts is nil, initialize an empty minio.GetObjectOptions struct.
+	if opts == nil {
+		opts = &minio.GetObjectOptions{}
+	}
+
+	// Use MinIO's GetObject method to open the object.
+	object, err := inst.client.GetObject(ctx, bucketName, objectName, *opts)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf(ErrFailedToGetObject, bucketName, err)
+	}
+
+	// Stat the object so that errors such as a missing key surface immediately.
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		cancel()
+		return nil, fmt.Errorf(ErrFailedToGetObject, bucketName, err)
+	}
+
+	return &objectStream{Object: object, cancel: cancel}, nil
+}
+
 // FGetObject downloads an object from the specified bucket and saves it to the provided file path.
 // It uses the timeout from the Service struct.
 func (inst *Service) FGetObject(bucketName, objectName, filePath string) error {
+	return inst.FGetObjectWithOptions(bucketName, objectName, filePath, nil)
+}
+
+// FGetObjectWithOptions downloads an object like FGetObject, accepting a pointer to minio.GetObjectOptions
+// for additional options, such as the ServerSideEncryption key required to read SSE-C encrypted objects.
+func (inst *Service) FGetObjectWithOptions(bucketName, objectName, filePath string, opts *minio.GetObjectOptions) error {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
+	// If opts is nil, initialize an empty minio.GetObjectOptions struct.
+	if opts == nil {
+		opts = &minio.GetObjectOptions{}
+	}
+
 	// Use MinIO's FGetObject to download the object and save it locally.
-	err := inst.client.FGetObject(ctx, bucketName, objectName, filePath, minio.GetObjectOptions{})
+	err := inst.client.FGetObject(ctx, bucketName, objectName, filePath, *opts)
 	if err != nil {
 		return fmt.Errorf(ErrFailedToGetObject, bucketName, err)
 	}
@@ -50,6 +115,8 @@ func (inst *Service) FGetObject(bucketName, objectName, filePath string) error {
 
 // PutObject uploads an object to the specified bucket using the provided object name and reader.
 // It accepts a pointer to minio.PutObjectOptions for additional options and uses the timeout from the Service struct.
+// Objects are stored unencrypted unless opts.ServerSideEncryption is set, e.g. to encrypt.NewSSE() for SSE-S3,
+// encrypt.NewSSEKMS for SSE-KMS or encrypt.NewSSEC for SSE-C, in which case reads must supply the same key.
 func (inst *Service) PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts *minio.PutObjectOptions) error {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
@@ -69,6 +136,31 @@ func (inst *Service) PutObject(bucketName, objectName string, reader io.Reader,
 	return nil
 }
 
+// PutObjectStream uploads an object to the specified bucket by streaming it from the provided reader.
+// The objectSize may be -1 when the length is unknown, in which case the upload is split into parts
+// of DefaultPartSize unless opts specifies a PartSize.
+// The Service timeout is not applied, since streaming may take arbitrarily long.
+func (inst *Service) PutObjectStream(bucketName, objectName string, reader io.Reader, objectSize int64, opts *minio.PutObjectOptions) error {
+	// Copy the options so the caller's struct is not modified.
+	var putOpts minio.PutObjectOptions
+	if opts != nil {
+		putOpts = *opts
+	}
+
+	// Bound the memory used per part when the object size is unknown.
+	if objectSize < 0 && putOpts.PartSize == 0 {
+		putOpts.PartSize = DefaultPartSize
+	}
+
+	// Stream the object to the bucket.
+	_, err := inst.client.PutObject(context.Background(), bucketName, objectName, reader, objectSize, putOpts)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToPutObject, bucketName, err)
+	}
+
+	return nil
+}
+
 // FPutObject uploads a file from the local filesystem to the specified bucket.
 // It accepts a pointer to minio.PutObjectOptions for additional options and uses the timeout from the Service struct.
 func (inst *Service) FPutObject(bucketName, objectName, filePath string, opts *minio.PutObjectOptions) error {
@@ -119,12 +211,23 @@ func (inst *Service) CopyObject(srcBucket, srcObject, destBucket, destObject str
 // StatObject retrieves metadata about an object in the specified bucket.
 // It uses the timeout from the Service struct.
 func (inst *Service) StatObject(bucketName, objectName string) (minio.ObjectInfo, error) {
+	return inst.StatObjectWithOptions(bucketName, objectName, nil)
+}
+
+// StatObjectWithOptions retrieves metadata about an object like StatObject, accepting a pointer to minio.StatObjectOptions
+// for additional options, such as the ServerSideEncryption key required to stat SSE-C encrypted objects.
+func (inst *Service) StatObjectWithOptions(bucketName, objectName string, opts *minio.StatObjectOptions) (minio.ObjectInfo, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
+	// If opts is nil, initialize an empty minio.StatObjectOptions struct.
+	if opts == nil {
+		opts = &minio.StatObjectOptions{}
+	}
+
 	// Get object metadata.
-	objectInfo, err := inst.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	objectInfo, err := inst.client.StatObject(ctx, bucketName, objectName, *opts)
 	if err != nil {
 		return minio.ObjectInfo{}, fmt.Errorf(ErrFailedToStatObject, objectName, bucketName, err)
 	}
@@ -132,6 +235,25 @@ func (inst *Service) StatObject(bucketName, objectName string) (minio.ObjectInfo
 	return objectInfo, nil
 }
 
+// ObjectExists checks whether an object exists in the specified bucket.
+// It returns false without an error when the object does not exist, and uses the timeout from the Service struct.
+func (inst *Service) ObjectExists(bucketName, objectName string) (bool, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Stat the object and translate a missing key into a false result.
+	_, err := inst.client.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return false, nil
+		}
+		return false, fmt.Errorf(ErrFailedToCheckObjectExistence, objectName, bucketName, err)
+	}
+
+	return true, nil
+}
+
 // RemoveObject deletes a single object from the specified bucket.
 // It uses the timeout from the Service struct.
 func (inst *Service) RemoveObject(bucketName, objectName string) error {
@@ -147,3 +269,82 @@ func (inst *Service) RemoveObject(bucketName, objectName string) error {
 
 	return nil
 }
+
+// RemoveObjects deletes multiple objects from the specified bucket in batched requests.
+// It attempts to remove every object and returns the first error encountered, if any.
+// It uses the timeout from the Service struct.
+func (inst *Service) RemoveObjects(bucketName string, objectNames ...string) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Feed the object names to MinIO.
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for _, objectName := range objectNames {
+			select {
+			case objectsCh <- minio.ObjectInfo{Key: objectName}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return inst.removeObjects(ctx, bucketName, objectsCh)
+}
+
+// removeObjects deletes the objects received on the channel from the specified bucket in batched requests,
+// including specific versions when VersionID is set. It returns the first error encountered, if any.
+func (inst *Service) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo) error {
+	// Drain all removal errors so the batch completes, keeping the first one.
+	var firstErr error
+	for removeErr := range inst.client.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if firstErr == nil {
+			firstErr = fmt.Errorf(ErrFailedToRemoveObject, removeErr.ObjectName, bucketName, removeErr.Err)
+		}
+	}
+
+	return firstErr
+}
+
+// ListObjectsInfo lists the objects in the specified bucket whose names start with the given prefix.
+// If recursive is true, objects in nested "directories" are included as well.
+// It returns the full metadata of each object and uses the timeout from the Service struct.
+func (inst *Service) ListObjectsInfo(bucketName, prefix string, recursive bool) ([]minio.ObjectInfo, error) {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Collect the objects streamed by MinIO, stopping at the first error.
+	var objects []minio.ObjectInfo
+	for object := range inst.client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: recursive,
+	}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf(ErrFailedToListObjects, bucketName, object.Err)
+		}
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// ListObjects lists the names of the objects in the specified bucket whose names start with the given prefix.
+// If recursive is true, objects in nested "directories" are included as well.
+// It uses the timeout from the Service struct.
+func (inst *Service) ListObjects(bucketName, prefix string, recursive bool) ([]string, error) {
+	objects, err := inst.ListObjectsInfo(bucketName, prefix, recursive)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the object keys.
+	keys := make([]string, len(objects))
+	for i, object := range objects {
+		keys[i] = object.Key
+	}
+
+	return keys, nil
+}
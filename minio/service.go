@@ -1,16 +1,25 @@
 package minio
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/nguyendang2000/shared-go/health"
+	"github.com/nguyendang2000/shared-go/metrics"
+	"github.com/nguyendang2000/shared-go/shutdown"
+	"github.com/nguyendang2000/shared-go/tracing"
 )
 
 // Service struct contains the MinIO client and a timeout field.
 type Service struct {
-	client  *minio.Client // The MinIO client instance.
-	timeout int64         // Timeout in seconds for requests.
+	client    *minio.Client     // The MinIO client instance.
+	transport *http.Transport   // HTTP transport used by the client, kept to release connections on Shutdown.
+	inflight  *shutdown.Tracker // Tracks in-flight requests for Shutdown.
+	timeout   int64             // Timeout in seconds for requests.
 }
 
 // NewService initializes a new MinIO connection using the given configuration
@@ -23,22 +32,81 @@ func NewService(conf Config) (*Service, error) {
 		timeout = DefaultTimeout
 	}
 
-	// Initialize the MinIO client.
-	minioClient, err := minio.New(conf.Address, &minio.Options{
+	// Prepare the client options, tracking each request so Shutdown can wait for it,
+	// and recording and tracing it if a metrics collector or tracer provider is provided.
+	transport, err := minio.DefaultTransport(conf.UseSSL)
+	if err != nil {
+		return nil, fmt.Errorf(ErrFailedToInitializeClient, err)
+	}
+	inflight := &shutdown.Tracker{}
+	opts := &minio.Options{
 		Creds:  credentials.NewStaticV4(conf.AccessKey, conf.SecretKey, ""),
 		Secure: conf.UseSSL,
-	})
+		Transport: shutdown.NewTransport(tracing.NewTransport(tracing.SystemMinio,
+			metrics.NewOperationTransport(metrics.SystemMinio, transport, conf.MetricsCollector, newOperationNamer(conf.Address)),
+			conf.TracerProvider), inflight),
+	}
+
+	// Initialize the MinIO client.
+	minioClient, err := minio.New(conf.Address, opts)
 	if err != nil {
 		return nil, fmt.Errorf(ErrFailedToInitializeClient, err)
 	}
 
-	return &Service{
-		client:  minioClient,
-		timeout: timeout,
-	}, nil
+	service := &Service{
+		client:    minioClient,
+		transport: transport,
+		inflight:  inflight,
+		timeout:   timeout,
+	}
+
+	// Verify the server is reachable, retrying if configured.
+	if conf.ConnectAttempts > 0 {
+		connectBackoff := conf.ConnectBackoff
+		if connectBackoff <= 0 {
+			connectBackoff = DefaultConnectBackoff
+		}
+		err := health.WaitFor(context.Background(), conf.ConnectAttempts, time.Duration(connectBackoff)*time.Second, func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+			defer cancel()
+			return service.Check(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return service, nil
+}
+
+// Shutdown gracefully closes the service: it stops accepting new requests, which then fail with shutdown.ErrShuttingDown,
+// waits for the in-flight ones to complete and releases the idle connections.
+// A download is in flight until its object is closed, so readers returned by GetObject must be closed.
+// The connections are released even if ctx is done first, in which case the context's error is returned.
+func (inst *Service) Shutdown(ctx context.Context) error {
+	err := inst.inflight.Drain(ctx)
+	inst.transport.CloseIdleConnections()
+
+	return err
 }
 
 // Client returns the MinIO client instance for direct use.
 func (inst *Service) Client() *minio.Client {
 	return inst.client
 }
+
+// Check verifies that the MinIO server is reachable and the credentials are valid using the given context.
+// It implements the health.Checker interface.
+func (inst *Service) Check(ctx context.Context) error {
+	_, err := inst.client.ListBuckets(ctx)
+	if err != nil {
+		return fmt.Errorf(ErrFailedToConnect, err)
+	}
+
+	return nil
+}
+
+// core returns a low-level MinIO client sharing the Service connection, used for multipart operations.
+func (inst *Service) core() *minio.Core {
+	return &minio.Core{Client: inst.client}
+}
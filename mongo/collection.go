@@ -0,0 +1,117 @@
+package mongo
+
+import "go.mongodb.org/mongo-driver/mongo"
+
+// Collection is a typed view of a single MongoDB collection whose documents decode into values of type T.
+// It is built on the Service methods, so it shares their timeouts and error handling.
+type Collection[T any] struct {
+	service        *Service
+	dbName         string
+	collectionName string
+}
+
+// NewCollection returns a typed view of the given collection in the given database.
+func NewCollection[T any](service *Service, dbName, collectionName string) *Collection[T] {
+	return &Collection[T]{
+		service:        service,
+		dbName:         dbName,
+		collectionName: collectionName,
+	}
+}
+
+// FindOne retrieves a single document matching the query filter.
+// It returns ErrDocumentNotFound if no document matches.
+func (inst *Collection[T]) FindOne(query *Query) (T, error) {
+	return inst.FindOneWithOptions(query, nil)
+}
+
+// FindOneWithOptions retrieves a single document matching the query filter, applying the optional sort order and collation.
+// It returns ErrDocumentNotFound if no document matches.
+func (inst *Collection[T]) FindOneWithOptions(query *Query, opts *FindOneOptions) (T, error) {
+	var result T
+	err := inst.service.FindOneWithOptions(inst.dbName, inst.collectionName, query, opts, &result)
+	return result, err
+}
+
+// FindMany retrieves the documents matching the query filter with the given limit, offset and sort order.
+func (inst *Collection[T]) FindMany(query *Query, limit int64, offset int64, sort []string) ([]T, error) {
+	var result []T
+	err := inst.service.FindMany(inst.dbName, inst.collectionName, query, limit, offset, sort, &result)
+	return result, err
+}
+
+// FindAll retrieves all documents matching the query filter, fetching them in batches of the given size.
+func (inst *Collection[T]) FindAll(query *Query, sort []string, batchSize int64) ([]T, error) {
+	var result []T
+	err := inst.service.FindAll(inst.dbName, inst.collectionName, query, sort, batchSize, &result)
+	return result, err
+}
+
+// Count returns the number of documents matching the query filter.
+func (inst *Collection[T]) Count(query *Query) (int64, error) {
+	return inst.service.Count(inst.dbName, inst.collectionName, query)
+}
+
+// Exists checks whether a document matching the query filter exists.
+func (inst *Collection[T]) Exists(query *Query) (bool, error) {
+	return inst.service.Exists(inst.dbName, inst.collectionName, query)
+}
+
+// InsertOne inserts a single document.
+func (inst *Collection[T]) InsertOne(document T) error {
+	return inst.service.InsertOne(inst.dbName, inst.collectionName, document)
+}
+
+// InsertMany inserts multiple documents.
+func (inst *Collection[T]) InsertMany(documents ...T) error {
+	values := make([]interface{}, len(documents))
+	for i, document := range documents {
+		values[i] = document
+	}
+
+	return inst.service.InsertMany(inst.dbName, inst.collectionName, values...)
+}
+
+// UpdateOne applies the update to a single document matching the query filter, inserting it if upsert is true and none matches.
+func (inst *Collection[T]) UpdateOne(query *Query, update *Query, upsert bool, arrayFilters ...*Query) (*mongo.UpdateResult, error) {
+	return inst.service.UpdateOne(inst.dbName, inst.collectionName, query, update, upsert, arrayFilters...)
+}
+
+// UpdateMany applies the update to all documents matching the query filter, inserting one if upsert is true and none matches.
+func (inst *Collection[T]) UpdateMany(query *Query, update *Query, upsert bool, arrayFilters ...*Query) (*mongo.UpdateResult, error) {
+	return inst.service.UpdateMany(inst.dbName, inst.collectionName, query, update, upsert, arrayFilters...)
+}
+
+// FindOneAndUpdate applies the update to a single document matching the query filter and returns it,
+// as it was before the update unless opts selects options.After.
+func (inst *Collection[T]) FindOneAndUpdate(query *Query, update *Query, opts *FindOneAndModifyOptions) (T, error) {
+	var result T
+	err := inst.service.FindOneAndUpdate(inst.dbName, inst.collectionName, query, update, &result, opts)
+	return result, err
+}
+
+// FindOneAndReplace replaces a single document matching the query filter and returns it,
+// as it was before the replacement unless opts selects options.After.
+func (inst *Collection[T]) FindOneAndReplace(query *Query, replacement T, opts *FindOneAndModifyOptions) (T, error) {
+	var result T
+	err := inst.service.FindOneAndReplace(inst.dbName, inst.collectionName, query, replacement, &result, opts)
+	return result, err
+}
+
+// FindOneAndUpsert applies the update to a single document matching the query filter, inserting it if none matches,
+// and returns the resulting document.
+func (inst *Collection[T]) FindOneAndUpsert(query *Query, update *Query) (T, error) {
+	var result T
+	err := inst.service.FindOneAndUpsert(inst.dbName, inst.collectionName, query, update, &result)
+	return result, err
+}
+
+// DeleteOne deletes a single document matching the query filter and returns the number of documents deleted.
+func (inst *Collection[T]) DeleteOne(query *Query) (int64, error) {
+	return inst.service.DeleteOne(inst.dbName, inst.collectionName, query)
+}
+
+// DeleteMany deletes all documents matching the query filter and returns the number of documents deleted.
+func (inst *Collection[T]) DeleteMany(query *Query) (int64, error) {
+	return inst.service.DeleteMany(inst.dbName, inst.collectionName, query)
+}
@@ -1,5 +1,10 @@
 package mongo
 
+import (
+	"github.com/nguyendang2000/shared-go/metrics"
+	"go.opentelemetry.io/otel/trace"
+)
+
 // Config represents the configuration settings required for connecting to a MongoDB server.
 type Config struct {
 	// Address specifies the address of the MongoDB server.
@@ -17,4 +22,52 @@ type Config struct {
 	// Timeout specifies the number of seconds before a request to MongoDB times out.
 	// This field is optional.
 	Timeout int64 `yaml:"timeout"`
+
+	// MaxPoolSize sets the maximum number of connections per server in the connection pool.
+	// This field is optional and defaults to the driver default (100).
+	MaxPoolSize uint64 `yaml:"max_pool_size"`
+
+	// MinPoolSize sets the minimum number of connections per server kept in the connection pool.
+	// This field is optional and defaults to the driver default (0).
+	MinPoolSize uint64 `yaml:"min_pool_size"`
+
+	// MaxConnIdleTime sets the number of seconds a connection can remain idle in the pool before being closed.
+	// This field is optional and defaults to the driver default (no limit).
+	MaxConnIdleTime int64 `yaml:"max_conn_idle_time"`
+
+	// ConnectAttempts sets how many times NewService tries to reach MongoDB before returning an error,
+	// so a service can start before MongoDB is ready. This field is optional and defaults to a single attempt.
+	ConnectAttempts int `yaml:"connect_attempts"`
+
+	// ConnectBackoff sets the number of seconds before retrying to reach MongoDB.
+	// The delay doubles after each failed attempt. This field is optional and defaults to 1 second.
+	ConnectBackoff int64 `yaml:"connect_backoff"`
+
+	// Reconnect enables a background monitor that pings MongoDB and rebuilds the client on persistent failures.
+	Reconnect bool `yaml:"reconnect"`
+
+	// ReconnectInterval sets the number of seconds between connection checks.
+	// This field is optional and defaults to 5 seconds.
+	ReconnectInterval int64 `yaml:"reconnect_interval"`
+
+	// ReconnectMaxBackoff caps the number of seconds between connection checks while disconnected.
+	// The delay doubles after each failed check. This field is optional and defaults to 60 seconds.
+	ReconnectMaxBackoff int64 `yaml:"reconnect_max_backoff"`
+
+	// ReconnectThreshold sets the number of consecutive failed checks before the client is rebuilt.
+	// This field is optional and defaults to 3.
+	ReconnectThreshold int `yaml:"reconnect_threshold"`
+
+	// OnStateChange is an optional callback invoked when the connection state changes,
+	// with the error of the failed check if any. It is called from the monitor goroutine.
+	OnStateChange func(state ConnectionState, err error) `yaml:"-"`
+
+	// MetricsCollector optionally records the latency and outcome of every command sent to MongoDB.
+	// This field is optional and metrics are disabled if nil.
+	MetricsCollector metrics.Collector `yaml:"-"`
+
+	// TracerProvider optionally traces every command sent to MongoDB in its own span. The Service methods do not
+	// take a context, so the spans are roots and do not join the caller's trace.
+	// This field is optional and tracing is disabled if nil.
+	TracerProvider trace.TracerProvider `yaml:"-"`
 }
@@ -5,3 +5,27 @@ const DefaultTimeout int64 = 30 // 30 seconds
 
 // DefaultBatchSize defines the default number of documents retrieved per batch.
 const DefaultBatchSize int64 = 1000
+
+// DefaultConnectBackoff is the default number of seconds before retrying to reach MongoDB in NewService.
+const DefaultConnectBackoff int64 = 1
+
+// DefaultReconnectInterval is the default number of seconds between connection checks when reconnection is enabled.
+const DefaultReconnectInterval int64 = 5
+
+// DefaultReconnectMaxBackoff is the default maximum number of seconds between connection checks while disconnected.
+const DefaultReconnectMaxBackoff int64 = 60
+
+// DefaultReconnectThreshold is the default number of consecutive failed checks before the client is rebuilt.
+const DefaultReconnectThreshold = 3
+
+// Connection states reported by the reconnect monitor.
+const (
+	// StateConnected indicates that the last connection check succeeded.
+	StateConnected ConnectionState = "connected"
+
+	// StateDisconnected indicates that the last connection check failed.
+	StateDisconnected ConnectionState = "disconnected"
+
+	// StateReconnecting indicates that the client is being rebuilt after persistent failures.
+	StateReconnecting ConnectionState = "reconnecting"
+)
@@ -0,0 +1,89 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// DropCollection drops the specified collection, including its documents and indexes.
+// Dropping a collection that does not exist is not an error.
+// It uses the timeout field from the Service struct.
+func (inst *Service) DropCollection(dbName, collectionName string) error {
+	// Use the timeout from the Service struct
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Drop the collection from the specified database
+	if err := inst.getClient().Database(dbName).Collection(collectionName).Drop(ctx); err != nil {
+		return fmt.Errorf(ErrFailedToDropCollection, err)
+	}
+
+	return nil
+}
+
+// DropDatabase drops the specified database, including all of its collections.
+// It uses the timeout field from the Service struct.
+func (inst *Service) DropDatabase(dbName string) error {
+	// Use the timeout from the Service struct
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Drop the specified database
+	if err := inst.getClient().Database(dbName).Drop(ctx); err != nil {
+		return fmt.Errorf(ErrFailedToDropDatabase, err)
+	}
+
+	return nil
+}
+
+// ListDatabases returns the names of all databases on the server.
+// It uses the timeout field from the Service struct.
+func (inst *Service) ListDatabases() ([]string, error) {
+	// Use the timeout from the Service struct
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// List the names of all databases, without filtering
+	names, err := inst.getClient().ListDatabaseNames(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf(ErrFailedToListDatabases, err)
+	}
+
+	return names, nil
+}
+
+// ListCollectionNames returns the names of all collections in the specified database.
+// It uses the timeout field from the Service struct.
+func (inst *Service) ListCollectionNames(dbName string) ([]string, error) {
+	// Use the timeout from the Service struct
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// List the names of all collections in the specified database, without filtering
+	names, err := inst.getClient().Database(dbName).ListCollectionNames(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf(ErrFailedToListCollections, err)
+	}
+
+	return names, nil
+}
+
+// RunCommand executes an arbitrary database command, such as collMod or serverStatus, against the specified database
+// and decodes the command reply into result, which must be a pointer.
+// It is an escape hatch for operations without a dedicated method and bypasses the Query builder and other abstractions.
+// It uses the timeout field from the Service struct.
+func (inst *Service) RunCommand(dbName string, command bson.D, result interface{}) error {
+	// Use the timeout from the Service struct
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Execute the command and decode the reply into the result
+	if err := inst.getClient().Database(dbName).RunCommand(ctx, command).Decode(result); err != nil {
+		return fmt.Errorf(ErrFailedToRunCommand, err)
+	}
+
+	return nil
+}
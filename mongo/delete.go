@@ -7,39 +7,41 @@ import (
 )
 
 // DeleteOne deletes a single document from the collection that matches the filter in the Query struct.
+// It returns the number of documents deleted, which is 0 if no document matched.
 // It uses the timeout defined in the Service struct to create a context for the operation.
-func (inst *Service) DeleteOne(dbName, collectionName string, query *Query) error {
+func (inst *Service) DeleteOne(dbName, collectionName string, query *Query) (int64, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
 	// Delete the document that matches the filter.
-	_, err := collection.DeleteOne(ctx, query.Filter)
+	result, err := collection.DeleteOne(ctx, query.Filter)
 	if err != nil {
-		return fmt.Errorf(ErrFailedToDeleteDocument, err)
+		return 0, fmt.Errorf(ErrFailedToDeleteDocument, err)
 	}
 
-	return nil
+	return result.DeletedCount, nil
 }
 
 // DeleteMany deletes multiple documents from the collection that match the filter in the Query struct.
+// It returns the number of documents deleted.
 // It uses the timeout defined in the Service struct to create a context for the operation.
-func (inst *Service) DeleteMany(dbName, collectionName string, query *Query) error {
+func (inst *Service) DeleteMany(dbName, collectionName string, query *Query) (int64, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
 	// Delete the documents that match the filter.
-	_, err := collection.DeleteMany(ctx, query.Filter)
+	result, err := collection.DeleteMany(ctx, query.Filter)
 	if err != nil {
-		return fmt.Errorf(ErrFailedToDeleteDocument, err)
+		return 0, fmt.Errorf(ErrFailedToDeleteDocument, err)
 	}
 
-	return nil
+	return result.DeletedCount, nil
 }
@@ -34,9 +34,39 @@ const (
 	// ErrFailedToUpdateDocument represents an error when updating a document in MongoDB fails.
 	ErrFailedToUpdateDocument = "failed to update document: %v"
 
+	// ErrFailedToFindOneAndUpdate represents an error when a find one and update operation fails.
+	ErrFailedToFindOneAndUpdate = "failed to find and update document: %v"
+
+	// ErrFailedToFindOneAndReplace represents an error when a find one and replace operation fails.
+	ErrFailedToFindOneAndReplace = "failed to find and replace document: %v"
+
 	// ErrFailedToCheckExistence represents an error when checking for the existence of a document fails.
 	ErrFailedToCheckExistence = "failed to check if document exists: %v"
 
+	// ErrFailedToDropCollection represents an error when dropping a collection fails.
+	ErrFailedToDropCollection = "failed to drop collection: %v"
+
+	// ErrFailedToDropDatabase represents an error when dropping a database fails.
+	ErrFailedToDropDatabase = "failed to drop database: %v"
+
+	// ErrFailedToListDatabases represents an error when listing the databases fails.
+	ErrFailedToListDatabases = "failed to list databases: %v"
+
+	// ErrFailedToListCollections represents an error when listing the collections of a database fails.
+	ErrFailedToListCollections = "failed to list collections: %v"
+
+	// ErrFailedToRunCommand represents an error when running a database command fails.
+	ErrFailedToRunCommand = "failed to run command: %v"
+
+	// ErrFailedToExplain represents an error when explaining a query fails.
+	ErrFailedToExplain = "failed to explain query: %v"
+
+	// ErrFailedToEnsureTTLIndex represents an error when creating or updating a TTL index fails.
+	ErrFailedToEnsureTTLIndex = "failed to ensure TTL index: %v"
+
+	// ErrInvalidTTLExpiry represents an error when a TTL index expiry shorter than one second is given.
+	ErrInvalidTTLExpiry = "invalid TTL index expiry %v on field %s: must be at least 1s"
+
 	// ErrInvalidResultArgument represents an error when the result argument is not a pointer to a slice.
 	ErrInvalidResultArgument = "result argument must be a pointer to a slice"
 )
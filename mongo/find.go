@@ -15,15 +15,33 @@ import (
 // FindOne retrieves a single document from the specified collection using the provided query filter.
 // The result is unmarshaled into the specified struct. It uses the timeout defined in the Service struct.
 func (inst *Service) FindOne(dbName, collectionName string, query *Query, result interface{}) error {
+	return inst.FindOneWithOptions(dbName, collectionName, query, nil, result)
+}
+
+// FindOneWithOptions retrieves a single document from the specified collection using the provided query filter,
+// applying the optional sort order and collation, e.g. to fetch the most recent document matching the filter.
+// The result is unmarshaled into the specified struct. It uses the timeout defined in the Service struct.
+func (inst *Service) FindOneWithOptions(dbName, collectionName string, query *Query, opts *FindOneOptions, result interface{}) error {
 	// Create a context with the timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
+
+	// Set the sort and collation options, if provided.
+	findOneOptions := options.FindOne()
+	if opts != nil {
+		if sortFields := parseSort(opts.Sort); len(sortFields) > 0 {
+			findOneOptions.SetSort(sortFields)
+		}
+		if opts.Collation != nil {
+			findOneOptions.SetCollation(opts.Collation)
+		}
+	}
 
 	// Execute FindOne and decode the result.
-	err := collection.FindOne(ctx, query.Filter).Decode(result)
+	err := collection.FindOne(ctx, query.Filter, findOneOptions).Decode(result)
 	if err != nil {
 		// Return ErrDocumentNotFound if no documents are found.
 		if err == mongo.ErrNoDocuments {
@@ -46,7 +64,7 @@ func (inst *Service) FindMany(dbName, collectionName string, query *Query, limit
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
 	// Set query options: limit, offset, and sorting.
 	findOptions := options.Find()
@@ -57,25 +75,8 @@ func (inst *Service) FindMany(dbName, collectionName string, query *Query, limit
 		findOptions.SetSkip(offset)
 	}
 
-	// Parse the sort parameter and convert it to MongoDB sort format.
-	sortFields := bson.D{}
-	for _, s := range sort {
-		order := 1 // Default to ascending order.
-		field := s
-
-		// Check for a + or - sign to set the sorting order.
-		if len(s) > 1 && (s[0] == '+' || s[0] == '-') {
-			field = s[1:] // Remove the first character (+ or -).
-			if s[0] == '-' {
-				order = -1 // Descending order.
-			}
-		}
-
-		sortFields = append(sortFields, bson.E{Key: field, Value: order})
-	}
-
-	// Apply the sort options if provided.
-	if len(sortFields) > 0 {
+	// Parse the sort parameter and apply it if provided.
+	if sortFields := parseSort(sort); len(sortFields) > 0 {
 		findOptions.SetSort(sortFields)
 	}
 
@@ -140,3 +141,57 @@ func (inst *Service) FindAll(dbName, collectionName string, query *Query, sort [
 
 	return nil
 }
+
+// Explain returns the winning query plan MongoDB would use to run FindMany with the same query filter,
+// limit, offset and sort, to help profile slow queries. The plan is computed with the "queryPlanner" verbosity,
+// so the query itself is not executed. It uses the timeout defined in the Service struct.
+func (inst *Service) Explain(dbName, collectionName string, query *Query, limit int64, offset int64, sort []string) (bson.M, error) {
+	// Build the find command, mirroring the options applied by FindMany.
+	find := bson.D{{Key: "find", Value: collectionName}, {Key: "filter", Value: query.Filter}}
+	if sortFields := parseSort(sort); len(sortFields) > 0 {
+		find = append(find, bson.E{Key: "sort", Value: sortFields})
+	}
+	if limit > 0 {
+		find = append(find, bson.E{Key: "limit", Value: limit})
+	}
+	if offset > 0 {
+		find = append(find, bson.E{Key: "skip", Value: offset})
+	}
+
+	// Explain the find command.
+	var reply bson.M
+	command := bson.D{{Key: "explain", Value: find}, {Key: "verbosity", Value: "queryPlanner"}}
+	if err := inst.RunCommand(dbName, command, &reply); err != nil {
+		return nil, fmt.Errorf(ErrFailedToExplain, err)
+	}
+
+	// Return the winning plan, or the whole reply if it has an unexpected shape.
+	if planner, ok := reply["queryPlanner"].(bson.M); ok {
+		if plan, ok := planner["winningPlan"].(bson.M); ok {
+			return plan, nil
+		}
+	}
+	return reply, nil
+}
+
+// parseSort converts sort fields, optionally prefixed with + (ascending, the default) or - (descending),
+// to the MongoDB sort format.
+func parseSort(sort []string) bson.D {
+	sortFields := bson.D{}
+	for _, s := range sort {
+		order := 1 // Default to ascending order.
+		field := s
+
+		// Check for a + or - sign to set the sorting order.
+		if len(s) > 1 && (s[0] == '+' || s[0] == '-') {
+			field = s[1:] // Remove the first character (+ or -).
+			if s[0] == '-' {
+				order = -1 // Descending order.
+			}
+		}
+
+		sortFields = append(sortFields, bson.E{Key: field, Value: order})
+	}
+
+	return sortFields
+}
@@ -0,0 +1,62 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// indexOptionsConflictCode is the MongoDB error code returned when an index exists with the same keys but different options.
+const indexOptionsConflictCode = 85
+
+// EnsureTTLIndex makes sure the specified collection has a TTL index on the given date field, so MongoDB automatically
+// deletes documents once expireAfter has elapsed since the field's value. It is idempotent: the index is created if missing,
+// and the expiry of an existing TTL index on the field is updated if it differs.
+// MongoDB stores the expiry in whole seconds, so expireAfter must be at least one second; an error is returned otherwise.
+// It uses the timeout field from the Service struct.
+func (inst *Service) EnsureTTLIndex(dbName, collectionName, field string, expireAfter time.Duration) error {
+	// Reject expiries that would truncate to zero seconds and delete documents immediately.
+	if expireAfter < time.Second {
+		return fmt.Errorf(ErrInvalidTTLExpiry, expireAfter, field)
+	}
+
+	// Use the timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Get the collection from the specified database.
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
+
+	// Create the TTL index, which is a no-op if an identical index already exists.
+	expireAfterSeconds := int32(expireAfter / time.Second)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: field, Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(expireAfterSeconds),
+	})
+	if err == nil {
+		return nil
+	}
+
+	// If the index exists with another expiry, update the expiry in place.
+	var commandErr mongo.CommandError
+	if !errors.As(err, &commandErr) || commandErr.Code != indexOptionsConflictCode {
+		return fmt.Errorf(ErrFailedToEnsureTTLIndex, err)
+	}
+	command := bson.D{
+		{Key: "collMod", Value: collectionName},
+		{Key: "index", Value: bson.D{
+			{Key: "keyPattern", Value: bson.D{{Key: field, Value: 1}}},
+			{Key: "expireAfterSeconds", Value: expireAfterSeconds},
+		}},
+	}
+	if err := inst.getClient().Database(dbName).RunCommand(ctx, command).Err(); err != nil {
+		return fmt.Errorf(ErrFailedToEnsureTTLIndex, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nguyendang2000/shared-go/metrics"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// newMetricsMonitor returns a command monitor recording each command, named after the command (e.g. "find", "insert"),
+// with the given metrics collector.
+func newMetricsMonitor(collector metrics.Collector) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			collector.Observe(metrics.SystemMongo, evt.CommandName, evt.Duration, nil)
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			collector.Observe(metrics.SystemMongo, evt.CommandName, evt.Duration, errors.New(evt.Failure))
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package mongo
+
+import "go.mongodb.org/mongo-driver/mongo/options"
+
+// FindOneAndModifyOptions holds the optional settings of FindOneAndUpdate and FindOneAndReplace.
+type FindOneAndModifyOptions struct {
+	// ReturnDocument selects whether the document is decoded as it was before (options.Before, the default)
+	// or after (options.After) the modification.
+	ReturnDocument options.ReturnDocument
+
+	// Upsert inserts a new document if no document matches the filter.
+	Upsert bool
+
+	// ArrayFilters select the array elements updated by filtered positional operators (e.g. items.$[elem].status).
+	// They only apply to FindOneAndUpdate.
+	ArrayFilters []*Query
+}
+
+// FindOneOptions holds the optional settings of FindOneWithOptions.
+type FindOneOptions struct {
+	// Sort lists the fields to sort by, each optionally prefixed with + (ascending, the default) or - (descending).
+	// The first matching document in this order is returned.
+	Sort []string
+
+	// Collation sets the language-specific rules used to compare strings, e.g. for case-insensitive matching.
+	Collation *options.Collation
+}
@@ -0,0 +1,61 @@
+package mongo
+
+import (
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// PoolStats represents a snapshot of the connection pool usage, as returned by Service.PoolStats.
+type PoolStats struct {
+	// Open is the number of connections currently open, whether in use or idle.
+	Open int64
+
+	// InUse is the number of connections currently checked out by operations.
+	InUse int64
+
+	// Idle is the number of open connections waiting in the pool.
+	Idle int64
+
+	// CheckoutFailures is the total number of times a connection could not be checked out of the pool.
+	CheckoutFailures int64
+}
+
+// poolCounters tracks connection pool events reported by the driver.
+type poolCounters struct {
+	open             atomic.Int64 // Number of open connections
+	inUse            atomic.Int64 // Number of checked out connections
+	checkoutFailures atomic.Int64 // Total number of failed checkouts
+}
+
+// newPoolMonitor returns a pool monitor updating the given counters on each connection pool event.
+func newPoolMonitor(counters *poolCounters) *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(evt *event.PoolEvent) {
+			switch evt.Type {
+			case event.ConnectionCreated:
+				counters.open.Add(1)
+			case event.ConnectionClosed:
+				counters.open.Add(-1)
+			case event.GetSucceeded:
+				counters.inUse.Add(1)
+			case event.ConnectionReturned:
+				counters.inUse.Add(-1)
+			case event.GetFailed:
+				counters.checkoutFailures.Add(1)
+			}
+		},
+	}
+}
+
+// PoolStats returns a snapshot of the connection pool usage across all servers the client is connected to.
+func (inst *Service) PoolStats() PoolStats {
+	open := inst.pool.open.Load()
+	inUse := inst.pool.inUse.Load()
+	return PoolStats{
+		Open:             open,
+		InUse:            inUse,
+		Idle:             open - inUse,
+		CheckoutFailures: inst.pool.checkoutFailures.Load(),
+	}
+}
@@ -62,20 +62,22 @@ func (q *Query) LessThanOrEqual(key string, value interface{}) *Query {
 }
 
 // Or adds an $or operator to the Query filter with multiple conditions.
+// The sub-query filters are copied, so mutating or reusing them afterwards does not affect this Query.
 func (q *Query) Or(queries ...*Query) *Query {
 	conditions := make([]bson.M, len(queries))
 	for i, query := range queries {
-		conditions[i] = query.Filter
+		conditions[i] = copyFilter(query.Filter)
 	}
 	q.Filter["$or"] = conditions
 	return q
 }
 
 // And adds an $and operator to the Query filter with multiple conditions.
+// The sub-query filters are copied, so mutating or reusing them afterwards does not affect this Query.
 func (q *Query) And(queries ...*Query) *Query {
 	conditions := make([]bson.M, len(queries))
 	for i, query := range queries {
-		conditions[i] = query.Filter
+		conditions[i] = copyFilter(query.Filter)
 	}
 	q.Filter["$and"] = conditions
 	return q
@@ -100,8 +102,9 @@ func (q *Query) Regex(key string, pattern string, options ...string) *Query {
 }
 
 // ElemMatch adds an $elemMatch operator to the Query filter to match elements in an array that satisfy the given Query conditions.
+// The match filter is copied, so mutating it afterwards does not affect this Query.
 func (q *Query) ElemMatch(key string, match *Query) *Query {
-	q.Filter[key] = bson.M{"$elemMatch": match.Filter}
+	q.Filter[key] = bson.M{"$elemMatch": copyFilter(match.Filter)}
 	return q
 }
 
@@ -136,3 +139,75 @@ func (q *Query) Incr(key string, value interface{}) *Query {
 	}
 	return q
 }
+
+// Raw sets a key of the Query filter to the given BSON document, allowing operators the builder does not know about,
+// such as $expr or $jsonSchema. Like the other builder methods, it replaces any existing value for the key.
+func (q *Query) Raw(key string, value bson.M) *Query {
+	q.Filter[key] = copyFilter(value)
+	return q
+}
+
+// Merge copies all keys of the given filter into the Query filter.
+// When a key already exists in the Query, the value from the merged filter takes precedence.
+func (q *Query) Merge(filter bson.M) *Query {
+	for key, value := range filter {
+		q.Filter[key] = copyValue(value)
+	}
+	return q
+}
+
+// Clone returns a deep copy of the Query, so it can be extended without affecting the original.
+func (q *Query) Clone() *Query {
+	return &Query{
+		Filter: copyFilter(q.Filter),
+	}
+}
+
+// copyFilter is a helper function to deep copy a bson.M filter.
+func copyFilter(filter bson.M) bson.M {
+	if filter == nil {
+		return nil
+	}
+	copied := make(bson.M, len(filter))
+	for key, value := range filter {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
+
+// copyValue is a helper function to recursively copy the maps and slices nested inside a filter.
+// Other values are returned as is.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.M:
+		return copyFilter(v)
+	case map[string]interface{}:
+		return map[string]interface{}(copyFilter(v))
+	case []bson.M:
+		copied := make([]bson.M, len(v))
+		for i, item := range v {
+			copied[i] = copyFilter(item)
+		}
+		return copied
+	case bson.D:
+		copied := make(bson.D, len(v))
+		for i, elem := range v {
+			copied[i] = bson.E{Key: elem.Key, Value: copyValue(elem.Value)}
+		}
+		return copied
+	case bson.A:
+		copied := make(bson.A, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,88 @@
+package mongo
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// newBaseQuery builds a query with nested maps, arrays and ordered documents.
+func newBaseQuery() *Query {
+	query := NewQuery().Field("status", "active").GreaterThan("age", 18)
+	query.Filter["tags"] = bson.A{"a", bson.M{"b": 1}}
+	query.Filter["sort"] = bson.D{{Key: "name", Value: bson.M{"order": 1}}}
+	return query
+}
+
+func TestCloneIsIndependentOfBase(t *testing.T) {
+	base := newBaseQuery()
+	clone := base.Clone()
+
+	if !reflect.DeepEqual(clone.Filter, base.Filter) {
+		t.Fatalf("clone = %v, want %v", clone.Filter, base.Filter)
+	}
+
+	// Mutate every nested level of the clone.
+	clone.Field("status", "archived")
+	clone.Filter["age"].(bson.M)["$gt"] = 65
+	clone.Filter["tags"].(bson.A)[0] = "z"
+	clone.Filter["tags"].(bson.A)[1].(bson.M)["b"] = 2
+	clone.Filter["sort"].(bson.D)[0].Value.(bson.M)["order"] = -1
+	clone.Set("updated", true)
+
+	if want := newBaseQuery(); !reflect.DeepEqual(base.Filter, want.Filter) {
+		t.Fatalf("base changed after mutating clone: got %v, want %v", base.Filter, want.Filter)
+	}
+}
+
+func TestCloneOfBaseIsNotAffectedByBaseMutation(t *testing.T) {
+	base := newBaseQuery()
+	clone := base.Clone()
+
+	base.Filter["age"].(bson.M)["$gt"] = 30
+	base.Filter["tags"].(bson.A)[1].(bson.M)["b"] = 3
+
+	if want := newBaseQuery(); !reflect.DeepEqual(clone.Filter, want.Filter) {
+		t.Fatalf("clone changed after mutating base: got %v, want %v", clone.Filter, want.Filter)
+	}
+}
+
+func TestComposedQueriesCopySubQueries(t *testing.T) {
+	a := NewQuery().Field("color", "red")
+	b := NewQuery().Field("size", "L")
+
+	or := NewQuery().Or(a, b)
+	elemMatch := NewQuery().ElemMatch("variants", a)
+
+	// Mutating the sub-query after composition must not leak into the parents.
+	a.Filter["color"] = "blue"
+	a.Filter["extra"] = true
+
+	wantOr := bson.M{"$or": []bson.M{{"color": "red"}, {"size": "L"}}}
+	if !reflect.DeepEqual(or.Filter, wantOr) {
+		t.Fatalf("Or filter = %v, want %v", or.Filter, wantOr)
+	}
+	wantElemMatch := bson.M{"variants": bson.M{"$elemMatch": bson.M{"color": "red"}}}
+	if !reflect.DeepEqual(elemMatch.Filter, wantElemMatch) {
+		t.Fatalf("ElemMatch filter = %v, want %v", elemMatch.Filter, wantElemMatch)
+	}
+}
+
+func TestSubQueryReusedInTwoParents(t *testing.T) {
+	shared := NewQuery().Field("status", "active")
+
+	first := NewQuery().And(shared)
+	second := NewQuery().Or(shared)
+
+	// Mutating the condition of one parent must not affect the other.
+	first.Filter["$and"].([]bson.M)[0]["status"] = "archived"
+
+	wantSecond := bson.M{"$or": []bson.M{{"status": "active"}}}
+	if !reflect.DeepEqual(second.Filter, wantSecond) {
+		t.Fatalf("second filter = %v, want %v", second.Filter, wantSecond)
+	}
+	if shared.Filter["status"] != "active" {
+		t.Fatalf("shared sub-query changed: %v", shared.Filter)
+	}
+}
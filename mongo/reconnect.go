@@ -0,0 +1,125 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ConnectionState represents the state of the connection to MongoDB, as observed by the reconnect monitor.
+type ConnectionState string
+
+// monitor periodically pings MongoDB until the context is canceled or the Service is closed. After ReconnectThreshold consecutive
+// failures it rebuilds the client, backing off exponentially up to ReconnectMaxBackoff between attempts.
+// State transitions are reported to the configured OnStateChange callback.
+func (inst *Service) monitor(ctx context.Context, conf Config) {
+	// Set the monitor settings, defaulting if not provided.
+	interval := conf.ReconnectInterval
+	if interval <= 0 {
+		interval = DefaultReconnectInterval
+	}
+	maxBackoff := conf.ReconnectMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultReconnectMaxBackoff
+	}
+	threshold := conf.ReconnectThreshold
+	if threshold <= 0 {
+		threshold = DefaultReconnectThreshold
+	}
+
+	delay := time.Duration(interval) * time.Second
+	failures := 0
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		// Stop monitoring once the Service is shutting down or closed.
+		if inst.closing.Load() {
+			return
+		}
+
+		// Ping MongoDB using the timeout from the Service struct.
+		pingCtx, cancel := context.WithTimeout(ctx, time.Duration(inst.timeout)*time.Second)
+		err := inst.Ping(pingCtx)
+		cancel()
+
+		if err == nil {
+			// The connection is healthy, so reset the backoff.
+			failures = 0
+			delay = time.Duration(interval) * time.Second
+			inst.setState(StateConnected, nil)
+		} else {
+			failures++
+			inst.setState(StateDisconnected, err)
+
+			// Rebuild the client once the failures persist.
+			if failures >= threshold {
+				failures = 0
+				inst.setState(StateReconnecting, err)
+				if err := inst.reconnect(); err != nil {
+					inst.setState(StateDisconnected, err)
+				}
+			}
+
+			// Back off exponentially while the connection is down.
+			delay *= 2
+			if delay > time.Duration(maxBackoff)*time.Second {
+				delay = time.Duration(maxBackoff) * time.Second
+			}
+		}
+
+		timer.Reset(delay)
+	}
+}
+
+// reconnect replaces the MongoDB client with a newly connected one and disconnects the previous client.
+// The new client is disconnected instead if the Service was closed in the meantime, so a closed Service stays closed.
+func (inst *Service) reconnect() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Connect a new client with the original options.
+	client, err := mongo.Connect(ctx, inst.clientOptions)
+	if err != nil {
+		return err
+	}
+
+	// Swap the clients and disconnect the previous one, unless the Service was closed.
+	inst.mu.Lock()
+	if inst.closing.Load() {
+		inst.mu.Unlock()
+		return client.Disconnect(ctx)
+	}
+	previous := inst.client
+	inst.client = client
+	inst.mu.Unlock()
+
+	return previous.Disconnect(ctx)
+}
+
+// setState records the connection state and invokes the OnStateChange callback if the state changed.
+func (inst *Service) setState(state ConnectionState, err error) {
+	inst.mu.Lock()
+	changed := inst.state != state
+	inst.state = state
+	inst.mu.Unlock()
+
+	if changed && inst.onStateChange != nil {
+		inst.onStateChange(state, err)
+	}
+}
+
+// State returns the last connection state observed by the reconnect monitor.
+// It is always StateConnected when reconnection is disabled.
+func (inst *Service) State() ConnectionState {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
+	return inst.state
+}
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/nguyendang2000/shared-go/health"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -15,8 +18,14 @@ import (
 
 // Service struct contains the MongoDB client and a timeout field
 type Service struct {
-	client  *mongo.Client
-	timeout int64 // Timeout in seconds for requests
+	mu            sync.RWMutex                 // Guards client and state, which change when reconnecting
+	client        *mongo.Client                // MongoDB client instance
+	clientOptions *options.ClientOptions       // Options used to rebuild the client when reconnecting
+	onStateChange func(ConnectionState, error) // Optional callback for connection state transitions
+	state         ConnectionState              // Last observed connection state
+	pool          *poolCounters                // Connection pool usage, updated by the pool monitor
+	closing       atomic.Bool                  // Whether Close or Shutdown has been called
+	timeout       int64                        // Timeout in seconds for requests
 }
 
 // NewService initializes a new MongoDB connection using the given configuration
@@ -38,6 +47,31 @@ func NewService(ctx context.Context, conf Config) (*Service, error) {
 		})
 	}
 
+	// Set connection pool limits, keeping the driver defaults if not provided
+	if conf.MaxPoolSize > 0 {
+		clientOptions.SetMaxPoolSize(conf.MaxPoolSize)
+	}
+	if conf.MinPoolSize > 0 {
+		clientOptions.SetMinPoolSize(conf.MinPoolSize)
+	}
+	if conf.MaxConnIdleTime > 0 {
+		clientOptions.SetMaxConnIdleTime(time.Duration(conf.MaxConnIdleTime) * time.Second)
+	}
+
+	// Track connection pool usage for PoolStats
+	pool := &poolCounters{}
+	clientOptions.SetPoolMonitor(newPoolMonitor(pool))
+
+	// Record each command with the metrics collector, if provided
+	if conf.MetricsCollector != nil {
+		clientOptions.SetMonitor(newMetricsMonitor(conf.MetricsCollector))
+	}
+
+	// Trace each command with the tracer provider, if provided
+	if conf.TracerProvider != nil {
+		clientOptions.SetMonitor(newTracingMonitor(conf.TracerProvider, clientOptions.Monitor))
+	}
+
 	// Set timeout to DefaultTimeout if not provided or less than 0
 	timeout := conf.Timeout
 	if timeout <= 0 {
@@ -55,44 +89,85 @@ func NewService(ctx context.Context, conf Config) (*Service, error) {
 		return nil, fmt.Errorf(ErrFailedToConnect, err)
 	}
 
-	// Ping the primary MongoDB node to verify connection
-	if err := client.Ping(connCtx, readpref.Primary()); err != nil {
+	// Ping the primary MongoDB node to verify connection, retrying if configured
+	connectBackoff := time.Duration(conf.ConnectBackoff) * time.Second
+	if connectBackoff <= 0 {
+		connectBackoff = time.Duration(DefaultConnectBackoff) * time.Second
+	}
+	err = health.WaitFor(ctx, conf.ConnectAttempts, connectBackoff, func() error {
+		pingCtx, cancel := context.WithTimeout(context.Background(), timeoutDuration)
+		defer cancel()
+		return client.Ping(pingCtx, readpref.Primary())
+	})
+	if err != nil {
 		return nil, fmt.Errorf(ErrFailedToPing, err)
 	}
 
 	// Service instance containing the MongoDB client and timeout
 	service := &Service{
-		client:  client,
-		timeout: timeout,
+		client:        client,
+		clientOptions: clientOptions,
+		onStateChange: conf.OnStateChange,
+		state:         StateConnected,
+		pool:          pool,
+		timeout:       timeout,
 	}
 
-	// Goroutine to listen for context cancellation and close MongoDB connection
+	// Goroutine to listen for context cancellation and gracefully shut the MongoDB connection down
 	go func() {
-		<-ctx.Done()                        // Wait for the context to be canceled
-		service.Close(context.Background()) // Close the MongoDB connection
+		<-ctx.Done() // Wait for the context to be canceled
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), timeoutDuration)
+		defer cancel()
+		service.Shutdown(shutdownCtx) // Close the MongoDB connection once in-flight operations complete
 	}()
 
+	// Start monitoring the connection in the background if reconnection is enabled
+	if conf.Reconnect {
+		go service.monitor(ctx, conf)
+	}
+
 	return service, nil
 }
 
-// Close closes the MongoDB client connection
+// Close closes the MongoDB client connection and stops the reconnect monitor, if any
 func (inst *Service) Close(ctx context.Context) error {
-	if err := inst.client.Disconnect(ctx); err != nil {
+	inst.closing.Store(true)
+	if err := inst.getClient().Disconnect(ctx); err != nil {
 		return fmt.Errorf("failed to close MongoDB connection: %v", err)
 	}
 	return nil
 }
 
+// Shutdown gracefully closes the MongoDB client connection. The driver stops accepting new operations
+// and waits for the connections used by in-flight operations to be returned to the pool, until ctx is done.
+func (inst *Service) Shutdown(ctx context.Context) error {
+	return inst.Close(ctx)
+}
+
 // Ping checks if MongoDB is still available
 func (inst *Service) Ping(ctx context.Context) error {
-	if err := inst.client.Ping(ctx, readpref.Primary()); err != nil {
+	if err := inst.getClient().Ping(ctx, readpref.Primary()); err != nil {
 		return fmt.Errorf(ErrFailedToPing, err)
 	}
 	return nil
 }
 
-// Client returns the MongoDB client instance
+// Check verifies that MongoDB is reachable using the given context.
+// It implements the health.Checker interface.
+func (inst *Service) Check(ctx context.Context) error {
+	return inst.Ping(ctx)
+}
+
+// Client returns the MongoDB client instance.
+// When reconnection is enabled the client may be replaced, so callers should not hold on to it.
 func (inst *Service) Client() *mongo.Client {
+	return inst.getClient()
+}
+
+// getClient returns the current MongoDB client, which may be replaced when reconnecting
+func (inst *Service) getClient() *mongo.Client {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
 	return inst.client
 }
 
@@ -104,7 +179,7 @@ func (inst *Service) Count(dbName, collectionName string, query *Query) (int64,
 	defer cancel()
 
 	// Get the collection from the specified database
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
 	// Count the number of documents matching the query
 	count, err := collection.CountDocuments(ctx, query.Filter)
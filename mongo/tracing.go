@@ -0,0 +1,45 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/nguyendang2000/shared-go/tracing"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTracingMonitor returns a command monitor tracing each command in its own span, named after the command
+// (e.g. "find", "insert"). The Service methods do not take a context, so the spans are roots and do not join
+// the caller's trace. Any monitor already set on the client options is chained.
+func newTracingMonitor(provider trace.TracerProvider, next *event.CommandMonitor) *event.CommandMonitor {
+	tracer := tracing.Tracer(provider)
+	var spans sync.Map // In-flight spans, keyed by request ID
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			_, span := tracing.Start(ctx, tracer, tracing.SystemMongo, evt.CommandName)
+			spans.Store(evt.RequestID, span)
+			if next != nil && next.Started != nil {
+				next.Started(ctx, evt)
+			}
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				tracing.End(span.(trace.Span), nil)
+			}
+			if next != nil && next.Succeeded != nil {
+				next.Succeeded(ctx, evt)
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				tracing.End(span.(trace.Span), errors.New(evt.Failure))
+			}
+			if next != nil && next.Failed != nil {
+				next.Failed(ctx, evt)
+			}
+		},
+	}
+}
@@ -5,51 +5,149 @@ import (
 	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // UpdateOne updates a single document in the collection that matches the filter and applies the update in the Query struct.
 // If upsert is true, it will insert the document if no matching document is found.
+// Optional array filters select the array elements updated by filtered positional operators, e.g. to update
+// items.$[elem].status, pass NewQuery().Field("elem.sku", sku) with the update NewQuery().Set("items.$[elem].status", status).
+// It returns the result holding the matched, modified and upserted counts, and the upserted ID if any.
 // The function uses the timeout defined in the Service struct to create a context for the operation.
-func (inst *Service) UpdateOne(dbName, collectionName string, query *Query, update *Query, upsert bool) error {
+func (inst *Service) UpdateOne(dbName, collectionName string, query *Query, update *Query, upsert bool, arrayFilters ...*Query) (*mongo.UpdateResult, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
-	// Set upsert option.
+	// Set upsert and array filters options.
 	updateOptions := options.Update().SetUpsert(upsert)
+	if len(arrayFilters) > 0 {
+		updateOptions.SetArrayFilters(toArrayFilters(arrayFilters))
+	}
 
 	// Update the document that matches the filter.
-	_, err := collection.UpdateOne(ctx, query.Filter, update.Filter, updateOptions)
+	result, err := collection.UpdateOne(ctx, query.Filter, update.Filter, updateOptions)
 	if err != nil {
-		return fmt.Errorf(ErrFailedToUpdateDocument, err)
+		return nil, fmt.Errorf(ErrFailedToUpdateDocument, err)
 	}
 
-	return nil
+	return result, nil
 }
 
 // UpdateMany updates multiple documents in the collection that match the filter and applies the update in the Query struct.
 // If upsert is true, it will insert the document if no matching documents are found.
+// Optional array filters select the array elements updated by filtered positional operators, e.g. to update
+// items.$[elem].status, pass NewQuery().Field("elem.sku", sku) with the update NewQuery().Set("items.$[elem].status", status).
+// It returns the result holding the matched, modified and upserted counts, and the upserted ID if any.
 // The function uses the timeout defined in the Service struct to create a context for the operation.
-func (inst *Service) UpdateMany(dbName, collectionName string, query *Query, update *Query, upsert bool) error {
+func (inst *Service) UpdateMany(dbName, collectionName string, query *Query, update *Query, upsert bool, arrayFilters ...*Query) (*mongo.UpdateResult, error) {
 	// Create a context with the specified timeout from the Service struct.
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
 	defer cancel()
 
 	// Get the collection from the specified database.
-	collection := inst.client.Database(dbName).Collection(collectionName)
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
 
-	// Set upsert option.
+	// Set upsert and array filters options.
 	updateOptions := options.Update().SetUpsert(upsert)
+	if len(arrayFilters) > 0 {
+		updateOptions.SetArrayFilters(toArrayFilters(arrayFilters))
+	}
 
 	// Update the documents that match the filter.
-	_, err := collection.UpdateMany(ctx, query.Filter, update.Filter, updateOptions)
+	result, err := collection.UpdateMany(ctx, query.Filter, update.Filter, updateOptions)
+	if err != nil {
+		return nil, fmt.Errorf(ErrFailedToUpdateDocument, err)
+	}
+
+	return result, nil
+}
+
+// FindOneAndUpdate updates a single document in the collection that matches the filter and applies the update in the Query struct.
+// The document is unmarshaled into the specified struct as it was before the update, unless opts selects options.After.
+// It returns ErrDocumentNotFound if no document matches and no document was upserted.
+// The function uses the timeout defined in the Service struct to create a context for the operation.
+func (inst *Service) FindOneAndUpdate(dbName, collectionName string, query *Query, update *Query, result interface{}, opts *FindOneAndModifyOptions) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Get the collection from the specified database.
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
+
+	// Set the return document, upsert and array filters options, if provided.
+	findOneAndUpdateOptions := options.FindOneAndUpdate()
+	if opts != nil {
+		findOneAndUpdateOptions.SetReturnDocument(opts.ReturnDocument).SetUpsert(opts.Upsert)
+		if len(opts.ArrayFilters) > 0 {
+			findOneAndUpdateOptions.SetArrayFilters(toArrayFilters(opts.ArrayFilters))
+		}
+	}
+
+	// Update the document and decode the result.
+	err := collection.FindOneAndUpdate(ctx, query.Filter, update.Filter, findOneAndUpdateOptions).Decode(result)
 	if err != nil {
-		return fmt.Errorf(ErrFailedToUpdateDocument, err)
+		// Return ErrDocumentNotFound if no documents are found.
+		if err == mongo.ErrNoDocuments {
+			return ErrDocumentNotFound
+		}
+		return fmt.Errorf(ErrFailedToFindOneAndUpdate, err)
 	}
 
 	return nil
 }
+
+// FindOneAndReplace replaces a single document in the collection that matches the filter with the given replacement document.
+// The document is unmarshaled into the specified struct as it was before the replacement, unless opts selects options.After.
+// It returns ErrDocumentNotFound if no document matches and no document was upserted.
+// The function uses the timeout defined in the Service struct to create a context for the operation.
+func (inst *Service) FindOneAndReplace(dbName, collectionName string, query *Query, replacement interface{}, result interface{}, opts *FindOneAndModifyOptions) error {
+	// Create a context with the specified timeout from the Service struct.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	defer cancel()
+
+	// Get the collection from the specified database.
+	collection := inst.getClient().Database(dbName).Collection(collectionName)
+
+	// Set the return document and upsert options, if provided.
+	findOneAndReplaceOptions := options.FindOneAndReplace()
+	if opts != nil {
+		findOneAndReplaceOptions.SetReturnDocument(opts.ReturnDocument).SetUpsert(opts.Upsert)
+	}
+
+	// Replace the document and decode the result.
+	err := collection.FindOneAndReplace(ctx, query.Filter, replacement, findOneAndReplaceOptions).Decode(result)
+	if err != nil {
+		// Return ErrDocumentNotFound if no documents are found.
+		if err == mongo.ErrNoDocuments {
+			return ErrDocumentNotFound
+		}
+		return fmt.Errorf(ErrFailedToFindOneAndReplace, err)
+	}
+
+	return nil
+}
+
+// FindOneAndUpsert updates a single document in the collection that matches the filter and applies the update in the Query struct,
+// inserting a new document if none matches. The resulting (post-update) document is unmarshaled into the specified struct.
+// The function uses the timeout defined in the Service struct to create a context for the operation.
+func (inst *Service) FindOneAndUpsert(dbName, collectionName string, query *Query, update *Query, result interface{}) error {
+	return inst.FindOneAndUpdate(dbName, collectionName, query, update, result, &FindOneAndModifyOptions{
+		ReturnDocument: options.After,
+		Upsert:         true,
+	})
+}
+
+// toArrayFilters converts the filters in the Query structs to MongoDB array filters.
+func toArrayFilters(queries []*Query) options.ArrayFilters {
+	filters := make([]interface{}, 0, len(queries))
+	for _, q := range queries {
+		filters = append(filters, q.Filter)
+	}
+
+	return options.ArrayFilters{Filters: filters}
+}
@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// GetOrLoad implements the cache-aside pattern: it returns the bytes cached under the key, or, on a cache miss,
+// calls loader and caches its result with the given expiration time before returning it.
+// Concurrent misses for the same key within this Service share a single loader call, avoiding cache stampedes.
+// A loader error is returned (wrapped) to every waiting caller and nothing is cached, so the next call retries the load.
+func (inst *Service) GetOrLoad(key string, ttl time.Duration, loader func() ([]byte, error)) ([]byte, error) {
+	data, err := inst.GetBytes(key)
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	// Deduplicate the load across concurrent callers missing the same key.
+	result, err, _ := inst.loads.Do(key, func() (interface{}, error) {
+		data, err := loader()
+		if err != nil {
+			return nil, fmt.Errorf(ErrLoad, key, err)
+		}
+
+		ctx, cancel := inst.getTimeout()
+		defer cancel()
+
+		if err := inst.getClient().Set(ctx, key, data, ttl).Err(); err != nil {
+			return nil, fmt.Errorf(ErrSet, key, err)
+		}
+
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]byte), nil
+}
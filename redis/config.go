@@ -1,17 +1,37 @@
 package redis
 
-import "crypto/tls"
+import (
+	"crypto/tls"
+
+	"github.com/nguyendang2000/shared-go/metrics"
+	"go.opentelemetry.io/otel/trace"
+)
 
 // Config represents the configuration settings for connecting to a Redis instance.
 // This struct supports YAML-based configuration for seamless integration with external config files.
 type Config struct {
+	// Mode selects the deployment to connect to: "single" (default), "cluster" or "sentinel".
+	Mode string `yaml:"mode"`
+
 	// Address specifies the Redis server address in the format "host:port".
+	// It is used in single mode, and as the only seed address in the other modes if Addresses is empty.
 	Address string `yaml:"address"`
 
+	// Addresses lists the cluster node addresses in cluster mode, or the sentinel addresses in sentinel mode,
+	// each in the format "host:port".
+	Addresses []string `yaml:"addresses"`
+
+	// MasterName is the name of the master monitored by the sentinels. It is required in sentinel mode.
+	MasterName string `yaml:"master_name"`
+
+	// SentinelPassword provides the optional password for authenticating with the sentinels.
+	SentinelPassword string `yaml:"sentinel_password"`
+
 	// Password provides the optional password for authenticating with the Redis server.
 	Password string `yaml:"password"`
 
 	// DB indicates the Redis database number to use. The default database is 0.
+	// It is ignored in cluster mode, which only supports database 0.
 	DB int `yaml:"db"`
 
 	// TLSConfig contains the TLS settings for establishing secure connections.
@@ -27,6 +47,49 @@ type Config struct {
 	MinIdleConns int `yaml:"min_idle_conns"`
 
 	// Timeout sets the maximum time, in seconds, for connection operations before they fail.
-	// This includes connection attempts and read/write operations.
+	// This includes connection attempts and read/write operations, unless overridden by the fields below.
 	Timeout int64 `yaml:"timeout"`
+
+	// DialTimeout optionally overrides Timeout, in seconds, for establishing new connections.
+	DialTimeout int64 `yaml:"dial_timeout"`
+
+	// ReadTimeout optionally overrides Timeout, in seconds, for socket reads.
+	ReadTimeout int64 `yaml:"read_timeout"`
+
+	// WriteTimeout optionally overrides Timeout, in seconds, for socket writes.
+	// Large pipeline writes may need a longer write timeout than reads.
+	WriteTimeout int64 `yaml:"write_timeout"`
+
+	// ConnectAttempts sets how many times NewService tries to reach Redis before returning an error,
+	// so a service can start before Redis is ready. The default is a single attempt.
+	ConnectAttempts int `yaml:"connect_attempts"`
+
+	// ConnectBackoff sets the delay, in seconds, before retrying to reach Redis.
+	// The delay doubles after each failed attempt. The default is 1 second.
+	ConnectBackoff int64 `yaml:"connect_backoff"`
+
+	// Reconnect enables a background monitor that pings Redis and rebuilds the client on persistent failures.
+	Reconnect bool `yaml:"reconnect"`
+
+	// ReconnectInterval sets the interval, in seconds, between connection checks. The default is 5 seconds.
+	ReconnectInterval int64 `yaml:"reconnect_interval"`
+
+	// ReconnectMaxBackoff caps the delay, in seconds, between connection checks while disconnected.
+	// The delay doubles after each failed check. The default is 60 seconds.
+	ReconnectMaxBackoff int64 `yaml:"reconnect_max_backoff"`
+
+	// ReconnectThreshold sets the number of consecutive failed checks before the client is rebuilt.
+	// The default is 3.
+	ReconnectThreshold int `yaml:"reconnect_threshold"`
+
+	// OnStateChange is an optional callback invoked when the connection state changes,
+	// with the error of the failed check if any. It is called from the monitor goroutine.
+	OnStateChange func(state ConnectionState, err error) `yaml:"-"`
+
+	// MetricsCollector optionally records the latency and outcome of every command. Metrics are disabled if nil.
+	MetricsCollector metrics.Collector `yaml:"-"`
+
+	// TracerProvider optionally traces every command in its own span. The Service methods do not take a context,
+	// so the spans are roots and do not join the caller's trace. Tracing is disabled if nil.
+	TracerProvider trace.TracerProvider `yaml:"-"`
 }
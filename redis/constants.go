@@ -33,4 +33,86 @@ const (
 	// DefaultClaimCount sets the default number of pending messages to claim
 	// when using the XAutoClaim command.
 	DefaultClaimCount = 100
+
+	// DefaultScanCount is the default number of elements requested per batch when iterating with SCAN commands.
+	DefaultScanCount = 100
+
+	// DefaultGeoUnit is the default distance unit for geospatial searches, in meters.
+	// Other supported units are "km", "mi" and "ft".
+	DefaultGeoUnit = "m"
+
+	// DefaultConnectBackoff is the default delay, in seconds, before retrying to reach Redis in NewService.
+	DefaultConnectBackoff = 1
+
+	// DefaultReconnectInterval is the default interval, in seconds, between connection checks when reconnection is enabled.
+	DefaultReconnectInterval = 5
+
+	// DefaultReconnectMaxBackoff is the default maximum delay, in seconds, between connection checks while disconnected.
+	DefaultReconnectMaxBackoff = 60
+
+	// DefaultReconnectThreshold is the default number of consecutive failed checks before the client is rebuilt.
+	DefaultReconnectThreshold = 3
+)
+
+// Connection states reported by the reconnect monitor.
+const (
+	// StateConnected indicates that the last connection check succeeded.
+	StateConnected ConnectionState = "connected"
+
+	// StateDisconnected indicates that the last connection check failed.
+	StateDisconnected ConnectionState = "disconnected"
+
+	// StateReconnecting indicates that the client is being rebuilt after persistent failures.
+	StateReconnecting ConnectionState = "reconnecting"
+)
+
+// Connection modes supported by Config.Mode.
+const (
+	// ModeSingle connects to a single Redis server. This is the default mode.
+	ModeSingle = "single"
+
+	// ModeCluster connects to a Redis Cluster.
+	ModeCluster = "cluster"
+
+	// ModeSentinel connects to a master discovered through Redis Sentinel, with automatic failover.
+	ModeSentinel = "sentinel"
+)
+
+// Bitwise operations supported by BitOp.
+const (
+	// BitOpAnd computes the bitwise AND of the source keys.
+	BitOpAnd = "AND"
+
+	// BitOpOr computes the bitwise OR of the source keys.
+	BitOpOr = "OR"
+
+	// BitOpXor computes the bitwise XOR of the source keys.
+	BitOpXor = "XOR"
+
+	// BitOpNot computes the bitwise NOT of a single source key.
+	BitOpNot = "NOT"
+)
+
+// Score aggregations supported by ZUnionStore and ZInterStore.
+const (
+	// ZAggregateSum sums the scores of a member across the source keys. This is the default aggregation.
+	ZAggregateSum = "SUM"
+
+	// ZAggregateMin keeps the lowest score of a member across the source keys.
+	ZAggregateMin = "MIN"
+
+	// ZAggregateMax keeps the highest score of a member across the source keys.
+	ZAggregateMax = "MAX"
+)
+
+// Field TTL statuses reported by HTTLDurations.
+const (
+	// TTLExpiring indicates that the hash field has an expiration set.
+	TTLExpiring FieldTTLStatus = "expiring"
+
+	// TTLPersistent indicates that the hash field exists but has no expiration.
+	TTLPersistent FieldTTLStatus = "persistent"
+
+	// TTLMissing indicates that the hash field or the key does not exist.
+	TTLMissing FieldTTLStatus = "missing"
 )
@@ -1,5 +1,7 @@
 package redis
 
+import "github.com/redis/go-redis/v9"
+
 // Error messages for Redis Service operations.
 // These constants define error messages for general Redis operations,
 // formatted with placeholders to allow dynamic values.
@@ -7,12 +9,27 @@ const (
 	// ErrPingRedis is returned when a connection ping to Redis fails.
 	ErrPingRedis = "failed to ping Redis: %w"
 
+	// ErrUnsupportedMode is returned when the configured connection mode is unknown.
+	ErrUnsupportedMode = "unsupported Redis mode %s"
+
+	// ErrMissingMasterName is returned when sentinel mode is configured without a master name.
+	ErrMissingMasterName = "master name is required in sentinel mode"
+
 	// ErrGet is returned when a GET operation for a key fails.
 	ErrGet = "failed to get key %s: %w"
 
 	// ErrSet is returned when a SET operation for a key fails.
 	ErrSet = "failed to set key %s: %w"
 
+	// ErrAppend is returned when appending a value to the string of a key fails.
+	ErrAppend = "failed to append to key %s: %w"
+
+	// ErrGetRange is returned when retrieving a substring of the string of a key fails.
+	ErrGetRange = "failed to get range %d to %d of key %s: %w"
+
+	// ErrSetRange is returned when overwriting part of the string of a key fails.
+	ErrSetRange = "failed to set range at offset %d of key %s: %w"
+
 	// ErrDelete is returned when a DELETE operation for one or more keys fails.
 	ErrDelete = "failed to delete keys %+v: %w"
 
@@ -25,11 +42,38 @@ const (
 	// ErrTTL is returned when retrieving the TTL of a key fails.
 	ErrTTL = "failed to get TTL of key %s: %w"
 
+	// ErrPTTL is returned when retrieving the TTL of a key in milliseconds fails.
+	ErrPTTL = "failed to get PTTL of key %s: %w"
+
+	// ErrType is returned when retrieving the type of a key fails.
+	ErrType = "failed to get type of key %s: %w"
+
+	// ErrObjectEncoding is returned when retrieving the internal encoding of a key fails.
+	ErrObjectEncoding = "failed to get encoding of key %s: %w"
+
+	// ErrMemoryUsage is returned when retrieving the memory usage of a key fails.
+	ErrMemoryUsage = "failed to get memory usage of key %s: %w"
+
 	// ErrIncr is returned when incrementing a key by 1 fails.
 	ErrIncr = "failed to increment key %s: %w"
 
 	// ErrIncrBy is returned when incrementing a key by a specified value fails.
 	ErrIncrBy = "failed to increment key %s by %d: %w"
+
+	// ErrInvalidTTL is returned when an expiration time shorter than one millisecond is given for a key.
+	ErrInvalidTTL = "invalid TTL %s for key %s: must be at least 1ms"
+
+	// ErrDecr is returned when decrementing a key by 1 fails.
+	ErrDecr = "failed to decrement key %s: %w"
+
+	// ErrDecrBy is returned when decrementing a key by a specified value fails.
+	ErrDecrBy = "failed to decrement key %s by %d: %w"
+
+	// ErrRename is returned when renaming a key fails.
+	ErrRename = "failed to rename key %s to %s: %w"
+
+	// ErrCopy is returned when copying a key fails.
+	ErrCopy = "failed to copy key %s to %s: %w"
 )
 
 // Error messages for Redis Hash operations.
@@ -67,6 +111,18 @@ const (
 
 	// ErrHLen is returned when retrieving the length (number of fields) of a hash fails.
 	ErrHLen = "failed to get length of key %s: %w"
+
+	// ErrHMGet is returned when retrieving multiple fields from a hash fails.
+	ErrHMGet = "failed to get fields %v in key %s: %w"
+
+	// ErrHMSet is returned when setting multiple fields in a hash with HMSET fails.
+	ErrHMSet = "failed to set multiple fields for key %s: %w"
+
+	// ErrHSetNX is returned when setting a hash field only if it does not exist fails.
+	ErrHSetNX = "failed to set field %s if not exists in key %s: %w"
+
+	// ErrHSetField is returned when setting a single field in a hash fails.
+	ErrHSetField = "failed to set field %s in key %s: %w"
 )
 
 // Error messages for Redis Stream operations.
@@ -90,3 +146,151 @@ const (
 	// ErrClaimPendingMessages is returned when claiming pending messages in a Redis stream fails.
 	ErrClaimPendingMessages = "failed to claim pending messages: %w"
 )
+
+// Error messages for Redis HyperLogLog operations.
+// These constants define error messages for operations involving Redis HyperLogLog data types.
+const (
+	// ErrPFAdd is returned when adding elements to a HyperLogLog fails.
+	ErrPFAdd = "failed to add elements to HyperLogLog %s: %w"
+
+	// ErrPFCount is returned when estimating the cardinality of one or more HyperLogLogs fails.
+	ErrPFCount = "failed to count HyperLogLog keys %+v: %w"
+
+	// ErrPFMerge is returned when merging HyperLogLogs into a destination key fails.
+	ErrPFMerge = "failed to merge HyperLogLog keys %+v into %s: %w"
+)
+
+// Error messages for Redis Bitmap operations.
+// These constants define error messages for operations involving Redis bitmaps.
+const (
+	// ErrSetBit is returned when setting a bit in a key fails.
+	ErrSetBit = "failed to set bit %d in key %s: %w"
+
+	// ErrGetBit is returned when retrieving a bit from a key fails.
+	ErrGetBit = "failed to get bit %d in key %s: %w"
+
+	// ErrBitCount is returned when counting the set bits of a key fails.
+	ErrBitCount = "failed to count bits in key %s: %w"
+
+	// ErrBitOp is returned when performing a bitwise operation between keys fails.
+	ErrBitOp = "failed to perform bitwise %s on keys %+v into %s: %w"
+
+	// ErrUnsupportedBitOp is returned when an unknown bitwise operation is requested.
+	ErrUnsupportedBitOp = "unsupported bitwise operation %s"
+
+	// ErrBitOpNotSingleSource is returned when a bitwise NOT is requested with other than exactly one source key.
+	ErrBitOpNotSingleSource = "bitwise NOT into %s requires exactly one source key, got %d"
+)
+
+// Error messages for Redis List operations.
+// These constants define error messages for operations involving Redis list data types.
+const (
+	// ErrLPush is returned when pushing values to the head of a list fails.
+	ErrLPush = "failed to push values to head of list %s: %w"
+
+	// ErrRPush is returned when pushing values to the tail of a list fails.
+	ErrRPush = "failed to push values to tail of list %s: %w"
+
+	// ErrLPop is returned when popping a value from the head of a list fails.
+	ErrLPop = "failed to pop value from head of list %s: %w"
+
+	// ErrRPop is returned when popping a value from the tail of a list fails.
+	ErrRPop = "failed to pop value from tail of list %s: %w"
+
+	// ErrLRange is returned when retrieving a range of values from a list fails.
+	ErrLRange = "failed to get range of list %s: %w"
+
+	// ErrLLen is returned when retrieving the length of a list fails.
+	ErrLLen = "failed to get length of list %s: %w"
+
+	// ErrBLPop is returned when a blocking pop from the head of one or more lists fails.
+	ErrBLPop = "failed to blocking pop from head of lists %+v: %w"
+
+	// ErrBRPop is returned when a blocking pop from the tail of one or more lists fails.
+	ErrBRPop = "failed to blocking pop from tail of lists %+v: %w"
+)
+
+// Error messages for Redis Set operations.
+// These constants define error messages for operations involving Redis sets.
+const (
+	// ErrSScan is returned when scanning the members of a set fails.
+	ErrSScan = "failed to scan members of key %s: %w"
+)
+
+// Error messages for Redis Sorted Set operations.
+// These constants define error messages for operations involving Redis sorted sets.
+const (
+	// ErrZPopMin is returned when popping the members with the lowest scores from a sorted set fails.
+	ErrZPopMin = "failed to pop members with lowest scores from key %s: %w"
+
+	// ErrZPopMax is returned when popping the members with the highest scores from a sorted set fails.
+	ErrZPopMax = "failed to pop members with highest scores from key %s: %w"
+
+	// ErrBZPopMin is returned when a blocking pop of the lowest scored member from one or more sorted sets fails.
+	ErrBZPopMin = "failed to blocking pop member with lowest score from keys %+v: %w"
+
+	// ErrBZPopMax is returned when a blocking pop of the highest scored member from one or more sorted sets fails.
+	ErrBZPopMax = "failed to blocking pop member with highest score from keys %+v: %w"
+
+	// ErrZMScore is returned when retrieving the scores of multiple members of a sorted set fails.
+	ErrZMScore = "failed to get scores of members %+v in key %s: %w"
+
+	// ErrZRevRange is returned when retrieving a range of a sorted set by rank in descending order fails.
+	ErrZRevRange = "failed to get reverse range %d to %d of key %s: %w"
+
+	// ErrZRevRangeByScore is returned when retrieving a range of a sorted set by score in descending order fails.
+	ErrZRevRangeByScore = "failed to get reverse range of key %s with scores from %s to %s: %w"
+
+	// ErrZUnionStore is returned when storing the union of sorted sets into a destination key fails.
+	ErrZUnionStore = "failed to store union of sorted sets %+v into %s: %w"
+
+	// ErrZInterStore is returned when storing the intersection of sorted sets into a destination key fails.
+	ErrZInterStore = "failed to store intersection of sorted sets %+v into %s: %w"
+
+	// ErrZDiffStore is returned when storing the difference of sorted sets into a destination key fails.
+	ErrZDiffStore = "failed to store difference of sorted sets %+v into %s: %w"
+
+	// ErrZRangeStore is returned when storing a range of a sorted set into a destination key fails.
+	ErrZRangeStore = "failed to store range %d to %d of sorted set %s into %s: %w"
+)
+
+// Error messages for Redis Geospatial operations.
+// These constants define error messages for operations involving Redis geospatial indexes.
+const (
+	// ErrGeoAdd is returned when adding members to a geospatial index fails.
+	ErrGeoAdd = "failed to add members to geospatial index %s: %w"
+
+	// ErrGeoSearch is returned when searching a geospatial index fails.
+	ErrGeoSearch = "failed to search geospatial index %s: %w"
+)
+
+// Error messages for Redis JSON value operations.
+// These constants define error messages for storing and retrieving JSON-encoded values.
+const (
+	// ErrMarshalJSON is returned when encoding a value to JSON for a key fails.
+	ErrMarshalJSON = "failed to marshal JSON value for key %s: %w"
+
+	// ErrUnmarshalJSON is returned when decoding the JSON value of a key fails.
+	ErrUnmarshalJSON = "failed to unmarshal JSON value of key %s: %w"
+)
+
+// Error messages for Redis cache-aside operations.
+// These constants define error messages for loading values on cache misses.
+const (
+	// ErrLoad is returned when the loader fails to produce the value of a missing key.
+	ErrLoad = "failed to load value for key %s: %w"
+)
+
+// Error messages for Redis rate limiting operations.
+// These constants define error messages for the fixed-window rate limiter.
+const (
+	// ErrRateLimit is returned when recording a request in the rate limiter fails.
+	ErrRateLimit = "failed to apply rate limit for key %s: %w"
+
+	// ErrInvalidRateLimit is returned when the limit is not positive or the window is shorter than one millisecond.
+	ErrInvalidRateLimit = "invalid rate limit of %d requests per %s for key %s: limit must be positive and window at least 1ms"
+)
+
+// ErrNil is an alias for redis.Nil, returned (wrapped) when a key does not exist.
+// Use errors.Is(err, ErrNil) to detect missing keys.
+var ErrNil = redis.Nil
@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// GeoLocation represents a named member of a Redis geospatial index and its coordinates.
+type GeoLocation struct {
+	// Name is the member name within the geospatial index.
+	Name string
+
+	// Longitude is the longitude of the member, in degrees.
+	Longitude float64
+
+	// Latitude is the latitude of the member, in degrees.
+	Latitude float64
+}
+
+// GeoResult represents a member returned by a geospatial search, including its distance from the search center.
+type GeoResult struct {
+	GeoLocation
+
+	// Distance is the distance from the search center, expressed in the unit used for the search.
+	Distance float64
+}
+
+// GeoAdd adds members with their coordinates to a Redis geospatial index, updating the positions of existing members.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) GeoAdd(key string, members ...GeoLocation) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	locations := make([]*redis.GeoLocation, len(members))
+	for i, member := range members {
+		locations[i] = &redis.GeoLocation{
+			Name:      member.Name,
+			Longitude: member.Longitude,
+			Latitude:  member.Latitude,
+		}
+	}
+
+	err := inst.getClient().GeoAdd(ctx, key, locations...).Err()
+	if err != nil {
+		return fmt.Errorf(ErrGeoAdd, key, err)
+	}
+
+	return nil
+}
+
+// GeoSearch retrieves the members of a Redis geospatial index located within the given radius of a center point,
+// sorted from nearest to farthest. The unit defaults to DefaultGeoUnit if not provided.
+// It uses the stored timeout in the Service struct and returns the members with their distances or an error if the operation fails.
+func (inst *Service) GeoSearch(key string, lng, lat, radius float64, unit string) ([]GeoResult, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	if unit == "" {
+		unit = DefaultGeoUnit
+	}
+
+	locations, err := inst.getClient().GeoSearchLocation(ctx, key, &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: redis.GeoSearchQuery{
+			Longitude:  lng,
+			Latitude:   lat,
+			Radius:     radius,
+			RadiusUnit: unit,
+			Sort:       "ASC",
+		},
+		WithCoord: true,
+		WithDist:  true,
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrGeoSearch, key, err)
+	}
+
+	results := make([]GeoResult, len(locations))
+	for i, location := range locations {
+		results[i] = GeoResult{
+			GeoLocation: GeoLocation{
+				Name:      location.Name,
+				Longitude: location.Longitude,
+				Latitude:  location.Latitude,
+			},
+			Distance: location.Dist,
+		}
+	}
+
+	return results, nil
+}
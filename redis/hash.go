@@ -5,13 +5,28 @@ import (
 	"time"
 )
 
+// FieldTTLStatus describes whether a hash field expires, persists or is missing.
+type FieldTTLStatus string
+
+// FieldTTL represents the time-to-live of a single hash field, as returned by HTTLDurations.
+type FieldTTL struct {
+	// Field is the name of the hash field.
+	Field string
+
+	// Status reports whether the field expires, has no expiration or does not exist.
+	Status FieldTTLStatus
+
+	// TTL is the remaining time-to-live of the field. It is only set when Status is TTLExpiring.
+	TTL time.Duration
+}
+
 // HGet retrieves the value of a specific field in a Redis hash.
 // It uses the stored timeout in the Service struct and returns the value or an error if the operation fails.
 func (inst *Service) HGet(key, field string) (string, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HGet(ctx, key, field).Result()
+	result, err := inst.getClient().HGet(ctx, key, field).Result()
 	if err != nil {
 		return "", fmt.Errorf(ErrHGet, field, key, err)
 	}
@@ -25,7 +40,7 @@ func (inst *Service) HGetAll(key string) (map[string]string, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HGetAll(ctx, key).Result()
+	result, err := inst.getClient().HGetAll(ctx, key).Result()
 	if err != nil {
 		return nil, fmt.Errorf(ErrHGetAll, key, err)
 	}
@@ -33,13 +48,36 @@ func (inst *Service) HGetAll(key string) (map[string]string, error) {
 	return result, nil
 }
 
+// HMGet retrieves the values of multiple fields in a Redis hash in a single round trip.
+// It uses the stored timeout in the Service struct and returns the values in the order of the given fields,
+// with an empty string for each field that does not exist, or an error if the operation fails.
+func (inst *Service) HMGet(key string, fields ...string) ([]string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().HMGet(ctx, key, fields...).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrHMGet, fields, key, err)
+	}
+
+	// Missing fields are returned as nil and mapped to empty strings.
+	values := make([]string, len(result))
+	for i, value := range result {
+		if value != nil {
+			values[i] = fmt.Sprint(value)
+		}
+	}
+
+	return values, nil
+}
+
 // HSet sets multiple fields and their values in a Redis hash.
 // It uses the stored timeout in the Service struct and returns an error if the operation fails.
 func (inst *Service) HSet(key string, fieldValues map[string]interface{}) error {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.HSet(ctx, key, fieldValues).Err()
+	err := inst.getClient().HSet(ctx, key, fieldValues).Err()
 	if err != nil {
 		return fmt.Errorf(ErrHSet, key, err)
 	}
@@ -47,13 +85,57 @@ func (inst *Service) HSet(key string, fieldValues map[string]interface{}) error
 	return nil
 }
 
+// HSetField sets a single field and its value in a Redis hash, overwriting any existing value.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) HSetField(key, field string, value interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err := inst.getClient().HSet(ctx, key, field, value).Err()
+	if err != nil {
+		return fmt.Errorf(ErrHSetField, field, key, err)
+	}
+
+	return nil
+}
+
+// HSetNX sets a field in a Redis hash only if the field does not already exist.
+// It uses the stored timeout in the Service struct and returns true if the field was set,
+// false if it already existed, or an error if the operation fails.
+func (inst *Service) HSetNX(key, field string, value interface{}) (bool, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().HSetNX(ctx, key, field, value).Result()
+	if err != nil {
+		return false, fmt.Errorf(ErrHSetNX, field, key, err)
+	}
+
+	return result, nil
+}
+
+// HMSet sets multiple fields and their values in a Redis hash using the HMSET command.
+// It is kept for compatibility with callers using the older command name and behaves like HSet.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) HMSet(key string, fieldValues map[string]interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err := inst.getClient().HMSet(ctx, key, fieldValues).Err()
+	if err != nil {
+		return fmt.Errorf(ErrHMSet, key, err)
+	}
+
+	return nil
+}
+
 // HDel deletes specific fields from a Redis hash.
 // It uses the stored timeout in the Service struct and returns an error if the operation fails.
 func (inst *Service) HDel(key string, fields ...string) error {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.HDel(ctx, key, fields...).Err()
+	err := inst.getClient().HDel(ctx, key, fields...).Err()
 	if err != nil {
 		return fmt.Errorf(ErrHDel, key, err)
 	}
@@ -67,7 +149,7 @@ func (inst *Service) HExists(key, field string) (bool, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	exists, err := inst.client.HExists(ctx, key, field).Result()
+	exists, err := inst.getClient().HExists(ctx, key, field).Result()
 	if err != nil {
 		return false, fmt.Errorf(ErrHExists, field, key, err)
 	}
@@ -81,7 +163,7 @@ func (inst *Service) HExpire(key string, expiration time.Duration, fields ...str
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.HExpire(ctx, key, expiration, fields...).Err()
+	err := inst.getClient().HExpire(ctx, key, expiration, fields...).Err()
 	if err != nil {
 		return fmt.Errorf(ErrHExpire, key, err)
 	}
@@ -95,7 +177,7 @@ func (inst *Service) HTTL(key string, fields ...string) ([]int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HTTL(ctx, key, fields...).Result()
+	result, err := inst.getClient().HTTL(ctx, key, fields...).Result()
 	if err != nil {
 		return nil, fmt.Errorf(ErrHTTL, key, err)
 	}
@@ -103,13 +185,41 @@ func (inst *Service) HTTL(key string, fields ...string) ([]int64, error) {
 	return result, nil
 }
 
+// HTTLDurations retrieves the time-to-live (TTL) for fields in a Redis hash, decoding the raw codes returned by HTTL.
+// It uses the stored timeout in the Service struct and returns one FieldTTL per field, in the order of the given fields,
+// or an error if the operation fails.
+func (inst *Service) HTTLDurations(key string, fields ...string) ([]FieldTTL, error) {
+	result, err := inst.HTTL(key, fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	ttls := make([]FieldTTL, len(result))
+	for i, seconds := range result {
+		ttls[i] = FieldTTL{Field: fields[i]}
+
+		// HTTL returns -1 for fields without expiration and -2 for missing fields or keys.
+		switch {
+		case seconds == -1:
+			ttls[i].Status = TTLPersistent
+		case seconds < 0:
+			ttls[i].Status = TTLMissing
+		default:
+			ttls[i].Status = TTLExpiring
+			ttls[i].TTL = time.Duration(seconds) * time.Second
+		}
+	}
+
+	return ttls, nil
+}
+
 // HIncrBy increments the value of a specific field in a Redis hash by the given amount.
 // It uses the stored timeout in the Service struct and returns the new value or an error if the operation fails.
 func (inst *Service) HIncrBy(key, field string, increment int64) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HIncrBy(ctx, key, field, increment).Result()
+	result, err := inst.getClient().HIncrBy(ctx, key, field, increment).Result()
 	if err != nil {
 		return -1, fmt.Errorf(ErrHIncrBy, field, increment, key, err)
 	}
@@ -123,7 +233,7 @@ func (inst *Service) HKeys(key string) ([]string, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HKeys(ctx, key).Result()
+	result, err := inst.getClient().HKeys(ctx, key).Result()
 	if err != nil {
 		return nil, fmt.Errorf(ErrHKeys, key, err)
 	}
@@ -137,7 +247,7 @@ func (inst *Service) HVals(key string) ([]string, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HVals(ctx, key).Result()
+	result, err := inst.getClient().HVals(ctx, key).Result()
 	if err != nil {
 		return nil, fmt.Errorf(ErrHVals, key, err)
 	}
@@ -151,7 +261,7 @@ func (inst *Service) HLen(key string) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.HLen(ctx, key).Result()
+	result, err := inst.getClient().HLen(ctx, key).Result()
 	if err != nil {
 		return -1, fmt.Errorf(ErrHLen, key, err)
 	}
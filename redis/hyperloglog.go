@@ -0,0 +1,46 @@
+package redis
+
+import "fmt"
+
+// PFAdd adds elements to a Redis HyperLogLog, creating it if it does not exist.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) PFAdd(key string, values ...interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err := inst.getClient().PFAdd(ctx, key, values...).Err()
+	if err != nil {
+		return fmt.Errorf(ErrPFAdd, key, err)
+	}
+
+	return nil
+}
+
+// PFCount returns the approximate number of unique elements in a Redis HyperLogLog.
+// When multiple keys are given, it returns the cardinality of their union.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) PFCount(keys ...string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().PFCount(ctx, keys...).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrPFCount, keys, err)
+	}
+
+	return result, nil
+}
+
+// PFMerge merges multiple Redis HyperLogLogs into the destination key, which holds the union of their elements.
+// It uses the stored timeout in the Service struct and returns an error if the operation fails.
+func (inst *Service) PFMerge(dest string, keys ...string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err := inst.getClient().PFMerge(ctx, dest, keys...).Err()
+	if err != nil {
+		return fmt.Errorf(ErrPFMerge, keys, dest, err)
+	}
+
+	return nil
+}
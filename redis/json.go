@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SetJSON encodes the value as JSON and stores it under the given key with an optional expiration time.
+// It returns an error if encoding or the operation fails.
+func (inst *Service) SetJSON(key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf(ErrMarshalJSON, key, err)
+	}
+
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err = inst.getClient().Set(ctx, key, data, expiration).Err()
+	if err != nil {
+		return fmt.Errorf(ErrSet, key, err)
+	}
+
+	return nil
+}
+
+// GetJSON retrieves the JSON value stored under the given key and decodes it into dest, which must be a pointer.
+// It returns an error matching ErrNil if the key does not exist, or an error if decoding or the operation fails.
+func (inst *Service) GetJSON(key string, dest interface{}) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	data, err := inst.getClient().Get(ctx, key).Bytes()
+	if err != nil {
+		return fmt.Errorf(ErrGet, key, err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf(ErrUnmarshalJSON, key, err)
+	}
+
+	return nil
+}
+
+// GetJSONAs retrieves the JSON value stored under the given key and decodes it into a value of type T.
+// It returns an error matching ErrNil if the key does not exist, or an error if decoding or the operation fails.
+func GetJSONAs[T any](inst *Service, key string) (T, error) {
+	var result T
+	if err := inst.GetJSON(key, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+)
+
+// LPush inserts values at the head of a Redis list, creating it if it does not exist.
+// It uses the stored timeout in the Service struct and returns the new length of the list or an error if the operation fails.
+func (inst *Service) LPush(key string, values ...interface{}) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().LPush(ctx, key, values...).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrLPush, key, err)
+	}
+
+	return result, nil
+}
+
+// RPush inserts values at the tail of a Redis list, creating it if it does not exist.
+// It uses the stored timeout in the Service struct and returns the new length of the list or an error if the operation fails.
+func (inst *Service) RPush(key string, values ...interface{}) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().RPush(ctx, key, values...).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrRPush, key, err)
+	}
+
+	return result, nil
+}
+
+// LPop removes and returns the first value of a Redis list.
+// It uses the stored timeout in the Service struct and returns an error if the list is empty or the operation fails.
+func (inst *Service) LPop(key string) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().LPop(ctx, key).Result()
+	if err != nil {
+		return "", fmt.Errorf(ErrLPop, key, err)
+	}
+
+	return result, nil
+}
+
+// RPop removes and returns the last value of a Redis list.
+// It uses the stored timeout in the Service struct and returns an error if the list is empty or the operation fails.
+func (inst *Service) RPop(key string) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().RPop(ctx, key).Result()
+	if err != nil {
+		return "", fmt.Errorf(ErrRPop, key, err)
+	}
+
+	return result, nil
+}
+
+// LRange retrieves the values of a Redis list between the start and stop indexes (inclusive).
+// Negative indexes count from the end of the list, so 0 and -1 return the whole list.
+// It uses the stored timeout in the Service struct and returns the values or an error if the operation fails.
+func (inst *Service) LRange(key string, start, stop int64) ([]string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().LRange(ctx, key, start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrLRange, key, err)
+	}
+
+	return result, nil
+}
+
+// LLen retrieves the number of values in a Redis list.
+// It uses the stored timeout in the Service struct and returns the length or an error if the operation fails.
+func (inst *Service) LLen(key string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().LLen(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrLLen, key, err)
+	}
+
+	return result, nil
+}
+
+// BLPop removes and returns the first value of the first non-empty list among the given keys,
+// blocking up to the given timeout until a value is available. A timeout of 0 blocks indefinitely.
+// It returns the name of the list and the popped value, or an error if the timeout expires or the operation fails.
+func (inst *Service) BLPop(timeout time.Duration, keys ...string) (string, string, error) {
+	ctx, cancel := inst.getBlockingTimeout(timeout)
+	defer cancel()
+
+	result, err := inst.getClient().BLPop(ctx, timeout, keys...).Result()
+	if err != nil {
+		return "", "", fmt.Errorf(ErrBLPop, keys, err)
+	}
+
+	return result[0], result[1], nil
+}
+
+// BRPop removes and returns the last value of the first non-empty list among the given keys,
+// blocking up to the given timeout until a value is available. A timeout of 0 blocks indefinitely.
+// It returns the name of the list and the popped value, or an error if the timeout expires or the operation fails.
+func (inst *Service) BRPop(timeout time.Duration, keys ...string) (string, string, error) {
+	ctx, cancel := inst.getBlockingTimeout(timeout)
+	defer cancel()
+
+	result, err := inst.getClient().BRPop(ctx, timeout, keys...).Result()
+	if err != nil {
+		return "", "", fmt.Errorf(ErrBRPop, keys, err)
+	}
+
+	return result[0], result[1], nil
+}
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/nguyendang2000/shared-go/metrics"
+	"github.com/redis/go-redis/v9"
+)
+
+// metricsHook is a go-redis hook recording each command with a metrics.Collector.
+type metricsHook struct {
+	collector metrics.Collector
+}
+
+// DialHook passes dialing through unchanged.
+func (inst metricsHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook records a single command, named after the command (e.g. "get", "hset").
+// A missing key (redis.Nil) is not recorded as an error.
+func (inst metricsHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		inst.collector.Observe(metrics.SystemRedis, cmd.FullName(), time.Since(start), observedError(err))
+		return err
+	}
+}
+
+// ProcessPipelineHook records a pipeline or transaction as a single "pipeline" operation.
+func (inst metricsHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		inst.collector.Observe(metrics.SystemRedis, "pipeline", time.Since(start), observedError(err))
+		return err
+	}
+}
+
+// observedError returns the error to record for a command, ignoring redis.Nil.
+func observedError(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
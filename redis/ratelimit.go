@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// rateLimitScript increments the counter of the current window and starts the window on the first request.
+// The expiration is also set when the counter has none, so a counter can never outlive its window.
+var rateLimitScript = redis.NewScript(`
+local current = redis.call("INCR", KEYS[1])
+if current == 1 or redis.call("PTTL", KEYS[1]) == -1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return current
+`)
+
+// RateLimiter limits the number of requests per key using fixed-window counters stored in Redis.
+// It is safe for concurrent use across goroutines and processes sharing the same Redis server.
+type RateLimiter struct {
+	service *Service
+}
+
+// NewRateLimiter creates a RateLimiter storing its counters through the given Service.
+func NewRateLimiter(service *Service) *RateLimiter {
+	return &RateLimiter{service: service}
+}
+
+// Allow records a request for the key and reports whether it is within limit requests per window,
+// along with the number of requests remaining in the current window.
+//
+// The algorithm is a fixed-window counter: the first request creates a counter expiring after window,
+// and each request increments it until it expires. The increment and expiration run in a single Lua script,
+// so they are atomic and concurrent callers never exceed the limit. As with any fixed window, up to twice the
+// limit can be allowed around a window boundary. Rejected requests are counted as well.
+// The key is used as is, so callers should namespace it, e.g. "ratelimit:login:<user>".
+// The limit must be positive and the window at least one millisecond, the resolution of PEXPIRE;
+// an error is returned without recording the request otherwise.
+func (inst *RateLimiter) Allow(key string, limit int, window time.Duration) (bool, int, error) {
+	if limit <= 0 || window < time.Millisecond {
+		return false, 0, fmt.Errorf(ErrInvalidRateLimit, limit, window, key)
+	}
+
+	ctx, cancel := inst.service.getTimeout()
+	defer cancel()
+
+	current, err := rateLimitScript.Run(ctx, inst.service.getClient(), []string{key}, window.Milliseconds()).Int()
+	if err != nil {
+		return false, 0, fmt.Errorf(ErrRateLimit, key, err)
+	}
+
+	remaining := limit - current
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return current <= limit, remaining, nil
+}
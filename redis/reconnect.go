@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// ConnectionState represents the state of the connection to Redis, as observed by the reconnect monitor.
+type ConnectionState string
+
+// monitor periodically pings Redis until the context is canceled or the Service is closed. After ReconnectThreshold consecutive
+// failures it rebuilds the client, backing off exponentially up to ReconnectMaxBackoff between attempts.
+// State transitions are reported to the configured OnStateChange callback.
+func (inst *Service) monitor(ctx context.Context, conf Config) {
+	// Set the monitor settings, defaulting if not provided.
+	interval := time.Duration(conf.ReconnectInterval) * time.Second
+	if interval <= 0 {
+		interval = DefaultReconnectInterval * time.Second
+	}
+	maxBackoff := time.Duration(conf.ReconnectMaxBackoff) * time.Second
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultReconnectMaxBackoff * time.Second
+	}
+	threshold := conf.ReconnectThreshold
+	if threshold <= 0 {
+		threshold = DefaultReconnectThreshold
+	}
+
+	delay := interval
+	failures := 0
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		// Stop monitoring once the Service is shutting down or closed.
+		if inst.inflight.Closing() || inst.isClosed() {
+			return
+		}
+
+		err := inst.Ping()
+		if err == nil {
+			// The connection is healthy, so reset the backoff.
+			failures = 0
+			delay = interval
+			inst.setState(StateConnected, nil)
+		} else {
+			failures++
+			inst.setState(StateDisconnected, err)
+
+			// Rebuild the client once the failures persist.
+			if failures >= threshold {
+				failures = 0
+				inst.setState(StateReconnecting, err)
+				inst.reconnect()
+			}
+
+			// Back off exponentially while the connection is down.
+			delay *= 2
+			if delay > maxBackoff {
+				delay = maxBackoff
+			}
+		}
+
+		timer.Reset(delay)
+	}
+}
+
+// reconnect replaces the Redis client with a newly built one and closes the previous client.
+// The new client is discarded if the Service was closed in the meantime, so a closed Service stays closed.
+func (inst *Service) reconnect() {
+	client := inst.newClient()
+
+	inst.mu.Lock()
+	if inst.closed {
+		inst.mu.Unlock()
+		client.Close()
+		return
+	}
+	previous := inst.client
+	inst.client = client
+	inst.mu.Unlock()
+
+	previous.Close()
+}
+
+// setState records the connection state and invokes the OnStateChange callback if the state changed.
+func (inst *Service) setState(state ConnectionState, err error) {
+	inst.mu.Lock()
+	changed := inst.state != state
+	inst.state = state
+	inst.mu.Unlock()
+
+	if changed && inst.onStateChange != nil {
+		inst.onStateChange(state, err)
+	}
+}
+
+// State returns the last connection state observed by the reconnect monitor.
+// It is always StateConnected when reconnection is disabled.
+func (inst *Service) State() ConnectionState {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
+	return inst.state
+}
@@ -2,17 +2,37 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/nguyendang2000/shared-go/health"
+	"github.com/nguyendang2000/shared-go/shutdown"
+	"github.com/nguyendang2000/shared-go/tracing"
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 )
 
 // Service represents a wrapper around a Redis client connection.
 // It includes methods for common Redis operations, with configurable timeouts.
+// The connection may target a single server, a Redis Cluster or a Sentinel-managed master.
 type Service struct {
-	client  *redis.Client // Redis client connection instance.
-	timeout int64         // Timeout for Redis operations, in seconds.
+	*connection               // Connection shared with the Services returned by WithTimeout.
+	timeout     time.Duration // Timeout for Redis operations.
+}
+
+// connection holds the Redis client and its state, shared by a Service and the Services derived from it with WithTimeout.
+type connection struct {
+	mu            sync.RWMutex                 // Guards client, state and closed, which change when reconnecting or closing.
+	closed        bool                         // Whether Close has been called, after which the client is not rebuilt.
+	client        redis.UniversalClient        // Redis client connection instance.
+	newClient     func() redis.UniversalClient // Factory used to rebuild the client when reconnecting.
+	onStateChange func(ConnectionState, error) // Optional callback for connection state transitions.
+	state         ConnectionState              // Last observed connection state.
+	db            int                          // Redis database number used by the client.
+	loads         singleflight.Group           // Deduplicates concurrent loads in GetOrLoad.
+	inflight      *shutdown.Tracker            // Tracks in-flight commands for Shutdown.
 }
 
 // NewService initializes a Redis connection using the provided configuration and context.
@@ -25,6 +45,20 @@ func NewService(ctx context.Context, conf Config) (*Service, error) {
 		timeout = DefaultTimeout
 	}
 
+	// Set dial, read and write timeouts, falling back to the general timeout if not provided.
+	dialTimeout := time.Duration(timeout) * time.Second
+	if conf.DialTimeout > 0 {
+		dialTimeout = time.Duration(conf.DialTimeout) * time.Second
+	}
+	readTimeout := time.Duration(timeout) * time.Second
+	if conf.ReadTimeout > 0 {
+		readTimeout = time.Duration(conf.ReadTimeout) * time.Second
+	}
+	writeTimeout := time.Duration(timeout) * time.Second
+	if conf.WriteTimeout > 0 {
+		writeTimeout = time.Duration(conf.WriteTimeout) * time.Second
+	}
+
 	// Set pool size, defaulting if not provided.
 	poolSize := conf.PoolSize
 	if poolSize == 0 {
@@ -37,50 +71,182 @@ func NewService(ctx context.Context, conf Config) (*Service, error) {
 		minIdleConns = DefaultMinIdleConns
 	}
 
-	// Configure Redis client options.
-	options := &redis.Options{
-		Addr:         conf.Address,                         // Address in the format "host:port".
-		Password:     conf.Password,                        // Password for Redis authentication.
-		DB:           conf.DB,                              // Redis database number.
-		TLSConfig:    conf.TLSConfig,                       // TLS configuration for secure connections (optional).
-		PoolSize:     poolSize,                             // Maximum number of connections in the pool.
-		MinIdleConns: minIdleConns,                         // Minimum number of idle connections in the pool.
-		DialTimeout:  time.Duration(timeout) * time.Second, // Timeout for establishing new connections.
-		ReadTimeout:  time.Duration(timeout) * time.Second, // Timeout for reading from Redis.
-		WriteTimeout: time.Duration(timeout) * time.Second, // Timeout for writing to Redis.
+	// Use the single address as the seed address if no addresses are provided.
+	addresses := conf.Addresses
+	if len(addresses) == 0 {
+		addresses = []string{conf.Address}
 	}
 
-	// Create a new Redis client.
-	client := redis.NewClient(options)
+	// Create a factory building Redis clients for the configured mode.
+	// The factory is kept so the client can be rebuilt when reconnecting.
+	var newClient func() redis.UniversalClient
+	db := conf.DB
+	switch conf.Mode {
+	case ModeSingle, "":
+		opts := redis.Options{
+			Addr:         conf.Address,   // Address in the format "host:port".
+			Password:     conf.Password,  // Password for Redis authentication.
+			DB:           conf.DB,        // Redis database number.
+			TLSConfig:    conf.TLSConfig, // TLS configuration for secure connections (optional).
+			PoolSize:     poolSize,       // Maximum number of connections in the pool.
+			MinIdleConns: minIdleConns,   // Minimum number of idle connections in the pool.
+			DialTimeout:  dialTimeout,    // Timeout for establishing new connections.
+			ReadTimeout:  readTimeout,    // Timeout for reading from Redis.
+			WriteTimeout: writeTimeout,   // Timeout for writing to Redis.
+		}
+		newClient = func() redis.UniversalClient {
+			o := opts
+			return redis.NewClient(&o)
+		}
+	case ModeCluster:
+		db = 0
+		opts := redis.ClusterOptions{
+			Addrs:        addresses,      // Seed addresses of the cluster nodes.
+			Password:     conf.Password,  // Password for Redis authentication.
+			TLSConfig:    conf.TLSConfig, // TLS configuration for secure connections (optional).
+			PoolSize:     poolSize,       // Maximum number of connections in the pool, per node.
+			MinIdleConns: minIdleConns,   // Minimum number of idle connections in the pool, per node.
+			DialTimeout:  dialTimeout,    // Timeout for establishing new connections.
+			ReadTimeout:  readTimeout,    // Timeout for reading from Redis.
+			WriteTimeout: writeTimeout,   // Timeout for writing to Redis.
+		}
+		newClient = func() redis.UniversalClient {
+			o := opts
+			return redis.NewClusterClient(&o)
+		}
+	case ModeSentinel:
+		if conf.MasterName == "" {
+			return nil, errors.New(ErrMissingMasterName)
+		}
+		opts := redis.FailoverOptions{
+			MasterName:       conf.MasterName,       // Name of the master monitored by the sentinels.
+			SentinelAddrs:    addresses,             // Addresses of the sentinels.
+			SentinelPassword: conf.SentinelPassword, // Password for sentinel authentication.
+			Password:         conf.Password,         // Password for Redis authentication.
+			DB:               conf.DB,               // Redis database number.
+			TLSConfig:        conf.TLSConfig,        // TLS configuration for secure connections (optional).
+			PoolSize:         poolSize,              // Maximum number of connections in the pool.
+			MinIdleConns:     minIdleConns,          // Minimum number of idle connections in the pool.
+			DialTimeout:      dialTimeout,           // Timeout for establishing new connections.
+			ReadTimeout:      readTimeout,           // Timeout for reading from Redis.
+			WriteTimeout:     writeTimeout,          // Timeout for writing to Redis.
+		}
+		newClient = func() redis.UniversalClient {
+			o := opts
+			return redis.NewFailoverClient(&o)
+		}
+	default:
+		return nil, fmt.Errorf(ErrUnsupportedMode, conf.Mode)
+	}
+
+	// Track each command as an in-flight operation, so Shutdown can wait for it.
+	inflight := &shutdown.Tracker{}
+	build := newClient
+	newClient = func() redis.UniversalClient {
+		client := build()
+		client.AddHook(shutdownHook{tracker: inflight})
+		return client
+	}
+
+	// Record each command with the metrics collector, if provided.
+	if conf.MetricsCollector != nil {
+		build := newClient
+		newClient = func() redis.UniversalClient {
+			client := build()
+			client.AddHook(metricsHook{collector: conf.MetricsCollector})
+			return client
+		}
+	}
+
+	// Trace each command with the tracer provider, if provided.
+	if conf.TracerProvider != nil {
+		build := newClient
+		newClient = func() redis.UniversalClient {
+			client := build()
+			client.AddHook(tracingHook{tracer: tracing.Tracer(conf.TracerProvider)})
+			return client
+		}
+	}
 
 	// Initialize the Service instance.
 	service := &Service{
-		client:  client,
-		timeout: timeout,
+		connection: &connection{
+			client:        newClient(),
+			newClient:     newClient,
+			onStateChange: conf.OnStateChange,
+			state:         StateConnected,
+			db:            db,
+			inflight:      inflight,
+		},
+		timeout: time.Duration(timeout) * time.Second,
 	}
 
-	// Close the Redis connection when the context is canceled.
+	// Shut the Redis connection down gracefully when the context is canceled.
 	go func() {
 		<-ctx.Done()
-		service.Close()
+		shutdownCtx, cancel := service.getTimeout()
+		defer cancel()
+		service.Shutdown(shutdownCtx)
 	}()
 
-	// Verify the Redis connection with a ping.
-	if err := service.Ping(); err != nil {
+	// Verify the Redis connection with a ping, retrying if configured.
+	connectBackoff := time.Duration(conf.ConnectBackoff) * time.Second
+	if connectBackoff <= 0 {
+		connectBackoff = DefaultConnectBackoff * time.Second
+	}
+	if err := health.WaitFor(ctx, conf.ConnectAttempts, connectBackoff, service.Ping); err != nil {
 		return nil, fmt.Errorf(ErrPingRedis, err)
 	}
 
+	// Start monitoring the connection in the background if reconnection is enabled.
+	if conf.Reconnect {
+		go service.monitor(ctx, conf)
+	}
+
 	return service, nil
 }
 
 // Client returns the underlying Redis client instance for advanced operations.
+// In cluster mode there is no single *redis.Client and nil is returned; use UniversalClient instead.
+// When reconnection is enabled the client may be replaced, so callers should not hold on to it.
 func (inst *Service) Client() *redis.Client {
+	client, _ := inst.getClient().(*redis.Client)
+	return client
+}
+
+// UniversalClient returns the underlying Redis client for advanced operations in any mode.
+// Depending on the configured mode, it is a *redis.Client, *redis.ClusterClient or failover *redis.Client.
+// When reconnection is enabled the client may be replaced, so callers should not hold on to it.
+func (inst *Service) UniversalClient() redis.UniversalClient {
+	return inst.getClient()
+}
+
+// getClient returns the current Redis client, which may be replaced when reconnecting.
+func (inst *Service) getClient() redis.UniversalClient {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
 	return inst.client
 }
 
+// WithTimeout returns a Service using the given timeout for each operation instead of the configured one,
+// e.g. service.WithTimeout(time.Minute).SMembersIter(key) to scan a large set.
+// The returned Service shares the connection of the original one, so it is cheap to create and needs no closing.
+func (inst *Service) WithTimeout(timeout time.Duration) *Service {
+	return &Service{connection: inst.connection, timeout: timeout}
+}
+
 // getTimeout returns a new context with the timeout specified in the Service.
 func (inst *Service) getTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), time.Duration(inst.timeout)*time.Second)
+	return context.WithTimeout(context.Background(), inst.timeout)
+}
+
+// getBlockingTimeout returns a new context for a blocking command, allowing it to wait for the given block duration
+// on top of the timeout specified in the Service. A block duration of 0 means the command may block indefinitely.
+func (inst *Service) getBlockingTimeout(block time.Duration) (context.Context, context.CancelFunc) {
+	if block == 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), block+inst.timeout)
 }
 
 // Ping tests the connection to the Redis server by sending a ping command.
@@ -89,7 +255,18 @@ func (inst *Service) Ping() error {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.Ping(ctx).Err()
+	err := inst.getClient().Ping(ctx).Err()
+	if err != nil {
+		return fmt.Errorf(ErrPingRedis, err)
+	}
+
+	return nil
+}
+
+// Check verifies that the Redis server is reachable using the given context.
+// It implements the health.Checker interface.
+func (inst *Service) Check(ctx context.Context) error {
+	err := inst.getClient().Ping(ctx).Err()
 	if err != nil {
 		return fmt.Errorf(ErrPingRedis, err)
 	}
@@ -97,9 +274,21 @@ func (inst *Service) Ping() error {
 	return nil
 }
 
-// Close gracefully closes the Redis client connection.
+// Close gracefully closes the Redis client connection and stops the reconnect monitor, if any.
 func (inst *Service) Close() error {
-	return inst.client.Close()
+	inst.mu.Lock()
+	inst.closed = true
+	client := inst.client
+	inst.mu.Unlock()
+
+	return client.Close()
+}
+
+// isClosed reports whether Close has been called.
+func (inst *Service) isClosed() bool {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
+	return inst.closed
 }
 
 // Get retrieves the value associated with the given key from Redis.
@@ -108,7 +297,7 @@ func (inst *Service) Get(key string) (string, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.Get(ctx, key).Result()
+	result, err := inst.getClient().Get(ctx, key).Result()
 	if err != nil {
 		return "", fmt.Errorf(ErrGet, key, err)
 	}
@@ -116,13 +305,28 @@ func (inst *Service) Get(key string) (string, error) {
 	return result, nil
 }
 
+// GetBytes retrieves the value associated with the given key from Redis as raw bytes.
+// Unlike Get, it is suited to binary values such as serialized or compressed payloads.
+// It returns an error matching ErrNil if the key does not exist, or an error if the operation fails.
+func (inst *Service) GetBytes(key string) ([]byte, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, fmt.Errorf(ErrGet, key, err)
+	}
+
+	return result, nil
+}
+
 // Set stores a key-value pair in Redis with an optional expiration time.
 // It returns an error if the operation fails.
 func (inst *Service) Set(key string, value interface{}, expiration time.Duration) error {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.Set(ctx, key, value, expiration).Err()
+	err := inst.getClient().Set(ctx, key, value, expiration).Err()
 	if err != nil {
 		return fmt.Errorf(ErrSet, key, err)
 	}
@@ -130,13 +334,57 @@ func (inst *Service) Set(key string, value interface{}, expiration time.Duration
 	return nil
 }
 
+// Append appends the value to the string stored at the given key, creating the key if it does not exist.
+// It returns the length of the string after the append or an error if the operation fails.
+func (inst *Service) Append(key, value string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().Append(ctx, key, value).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrAppend, key, err)
+	}
+
+	return result, nil
+}
+
+// GetRange retrieves the substring of the string stored at the given key between the start and end offsets,
+// both inclusive, with negative offsets counting from the end of the string.
+// It returns the substring, which is empty if the key does not exist, or an error if the operation fails.
+func (inst *Service) GetRange(key string, start, end int64) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().GetRange(ctx, key, start, end).Result()
+	if err != nil {
+		return "", fmt.Errorf(ErrGetRange, start, end, key, err)
+	}
+
+	return result, nil
+}
+
+// SetRange overwrites part of the string stored at the given key, starting at the specified offset.
+// The string is padded with zero bytes if it is shorter than the offset, and created if the key does not exist.
+// It returns the length of the string after the operation or an error if the operation fails.
+func (inst *Service) SetRange(key string, offset int64, value string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().SetRange(ctx, key, offset, value).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrSetRange, offset, key, err)
+	}
+
+	return result, nil
+}
+
 // Del deletes one or more keys from Redis and returns the number of keys deleted.
 // It returns an error if the operation fails.
 func (inst *Service) Del(keys ...string) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.Del(ctx, keys...).Result()
+	result, err := inst.getClient().Del(ctx, keys...).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrDelete, keys, err)
 	}
@@ -150,7 +398,7 @@ func (inst *Service) Exists(keys ...string) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.Exists(ctx, keys...).Result()
+	result, err := inst.getClient().Exists(ctx, keys...).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrExists, keys, err)
 	}
@@ -164,7 +412,7 @@ func (inst *Service) Expire(key string, expiration time.Duration) error {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	err := inst.client.Expire(ctx, key, expiration).Err()
+	err := inst.getClient().Expire(ctx, key, expiration).Err()
 	if err != nil {
 		return fmt.Errorf(ErrExpire, key, err)
 	}
@@ -178,7 +426,7 @@ func (inst *Service) TTL(key string) (time.Duration, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	ttl, err := inst.client.TTL(ctx, key).Result()
+	ttl, err := inst.getClient().TTL(ctx, key).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrTTL, key, err)
 	}
@@ -186,13 +434,69 @@ func (inst *Service) TTL(key string) (time.Duration, error) {
 	return ttl, nil
 }
 
+// PTTL retrieves the time-to-live (TTL) remaining for a specific key with millisecond precision.
+// It returns the TTL as a duration or an error if the operation fails.
+func (inst *Service) PTTL(key string) (time.Duration, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	ttl, err := inst.getClient().PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrPTTL, key, err)
+	}
+
+	return ttl, nil
+}
+
+// Type retrieves the type of the value stored at a key (e.g., "string", "hash", "list", "set", "zset", "stream").
+// It returns "none" if the key does not exist, or an error if the operation fails.
+func (inst *Service) Type(key string) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().Type(ctx, key).Result()
+	if err != nil {
+		return "", fmt.Errorf(ErrType, key, err)
+	}
+
+	return result, nil
+}
+
+// ObjectEncoding retrieves the internal encoding Redis uses for the value stored at a key (e.g., "listpack", "hashtable").
+// It returns the encoding or an error if the operation fails.
+func (inst *Service) ObjectEncoding(key string) (string, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ObjectEncoding(ctx, key).Result()
+	if err != nil {
+		return "", fmt.Errorf(ErrObjectEncoding, key, err)
+	}
+
+	return result, nil
+}
+
+// MemoryUsage retrieves the number of bytes a key and its value occupy in memory.
+// It returns the memory usage or an error if the operation fails.
+func (inst *Service) MemoryUsage(key string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().MemoryUsage(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrMemoryUsage, key, err)
+	}
+
+	return result, nil
+}
+
 // Incr increments the integer value of a key by one.
 // It returns the new value or an error if the operation fails.
 func (inst *Service) Incr(key string) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.Incr(ctx, key).Result()
+	result, err := inst.getClient().Incr(ctx, key).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrIncr, key, err)
 	}
@@ -206,10 +510,113 @@ func (inst *Service) IncrBy(key string, increment int64) (int64, error) {
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.IncrBy(ctx, key, increment).Result()
+	result, err := inst.getClient().IncrBy(ctx, key, increment).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrIncrBy, key, increment, err)
 	}
 
 	return result, nil
 }
+
+// incrByEXScript increments a key and sets its expiration only when the increment created the key.
+var incrByEXScript = redis.NewScript(`
+local created = redis.call("EXISTS", KEYS[1]) == 0
+local current = redis.call("INCRBY", KEYS[1], ARGV[1])
+if created then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return current
+`)
+
+// IncrByEX increments the value of the given key by the specified amount and, if the key did not exist,
+// sets its expiration time, so that counters reset once the TTL elapses after their first increment.
+// The increment and the expiration run atomically in a Lua script; the expiration of an existing key is left unchanged.
+// The TTL must be at least one millisecond, the resolution of PEXPIRE, as a shorter TTL would delete the counter
+// right after creating it; an error is returned without touching the key otherwise.
+// It returns the new value or an error if the operation fails.
+func (inst *Service) IncrByEX(key string, increment int64, ttl time.Duration) (int64, error) {
+	if ttl < time.Millisecond {
+		return 0, fmt.Errorf(ErrInvalidTTL, ttl, key)
+	}
+
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := incrByEXScript.Run(ctx, inst.getClient(), []string{key}, increment, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf(ErrIncrBy, key, increment, err)
+	}
+
+	return result, nil
+}
+
+// Decr decrements the integer value of a key by one.
+// It returns the new value or an error if the operation fails.
+func (inst *Service) Decr(key string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().Decr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrDecr, key, err)
+	}
+
+	return result, nil
+}
+
+// DecrBy decrements the value of the given key by the specified amount.
+// It returns the new value or an error if the operation fails.
+func (inst *Service) DecrBy(key string, decrement int64) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().DecrBy(ctx, key, decrement).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrDecrBy, key, decrement, err)
+	}
+
+	return result, nil
+}
+
+// Rename renames a key, overwriting the destination key if it already exists.
+// It returns an error if the source key does not exist or the operation fails.
+func (inst *Service) Rename(src, dst string) error {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	err := inst.getClient().Rename(ctx, src, dst).Err()
+	if err != nil {
+		return fmt.Errorf(ErrRename, src, dst, err)
+	}
+
+	return nil
+}
+
+// RenameNX renames a key only if the destination key does not already exist.
+// It returns true if the key was renamed, false if the destination exists, or an error if the operation fails.
+func (inst *Service) RenameNX(src, dst string) (bool, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().RenameNX(ctx, src, dst).Result()
+	if err != nil {
+		return false, fmt.Errorf(ErrRename, src, dst, err)
+	}
+
+	return result, nil
+}
+
+// Copy copies the value of a key to the destination key within the same database.
+// If replace is false, the copy is skipped when the destination already exists.
+// It returns true if the key was copied, or an error if the operation fails.
+func (inst *Service) Copy(src, dst string, replace bool) (bool, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().Copy(ctx, src, dst, inst.db, replace).Result()
+	if err != nil {
+		return false, fmt.Errorf(ErrCopy, src, dst, err)
+	}
+
+	return result == 1, nil
+}
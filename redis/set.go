@@ -0,0 +1,77 @@
+package redis
+
+import "fmt"
+
+// SetIterator iterates over the members of a Redis set in batches fetched with SSCAN,
+// so large sets can be processed without loading all members into memory.
+// It is not safe for concurrent use.
+type SetIterator struct {
+	service *Service
+	key     string
+	cursor  uint64
+	batch   []string
+	current string
+	started bool
+	err     error
+}
+
+// SMembersIter returns an iterator over the members of a Redis set, fetching DefaultScanCount members per batch with SSCAN.
+// Each batch uses the stored timeout in the Service struct. As with SSCAN, a member may be returned more than once
+// if the set is modified during the iteration, and members added or removed meanwhile may or may not be returned.
+//
+// Typical usage:
+//
+//	iter := service.SMembersIter(key)
+//	for iter.Next() {
+//		member := iter.Val()
+//	}
+//	if err := iter.Err(); err != nil {
+//		// handle error
+//	}
+func (inst *Service) SMembersIter(key string) *SetIterator {
+	return &SetIterator{service: inst, key: key}
+}
+
+// Next advances the iterator to the next member, fetching a new batch if needed.
+// It returns false when the iteration is complete or an error occurred, which is then reported by Err.
+func (inst *SetIterator) Next() bool {
+	for len(inst.batch) == 0 {
+		// A zero cursor after the first batch means the iteration is complete.
+		if inst.err != nil || (inst.started && inst.cursor == 0) {
+			return false
+		}
+		inst.started = true
+
+		if err := inst.fetch(); err != nil {
+			inst.err = err
+			return false
+		}
+	}
+
+	inst.current, inst.batch = inst.batch[0], inst.batch[1:]
+	return true
+}
+
+// Val returns the current member.
+func (inst *SetIterator) Val() string {
+	return inst.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (inst *SetIterator) Err() error {
+	return inst.err
+}
+
+// fetch retrieves the next batch of members with SSCAN.
+func (inst *SetIterator) fetch() error {
+	ctx, cancel := inst.service.getTimeout()
+	defer cancel()
+
+	members, cursor, err := inst.service.getClient().SScan(ctx, inst.key, inst.cursor, "", DefaultScanCount).Result()
+	if err != nil {
+		return fmt.Errorf(ErrSScan, inst.key, err)
+	}
+	inst.batch, inst.cursor = members, cursor
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"net"
+
+	"github.com/nguyendang2000/shared-go/shutdown"
+	"github.com/redis/go-redis/v9"
+)
+
+// shutdownHook is a go-redis hook tracking each command as an in-flight operation, so Shutdown can wait for it.
+type shutdownHook struct {
+	tracker *shutdown.Tracker
+}
+
+// DialHook passes dialing through unchanged.
+func (inst shutdownHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook tracks a single command, rejecting it with shutdown.ErrShuttingDown once Shutdown has been called.
+func (inst shutdownHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := inst.tracker.Begin(); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		defer inst.tracker.End()
+
+		return next(ctx, cmd)
+	}
+}
+
+// ProcessPipelineHook tracks a pipeline or transaction as a single operation.
+func (inst shutdownHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := inst.tracker.Begin(); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		defer inst.tracker.End()
+
+		return next(ctx, cmds)
+	}
+}
+
+// Shutdown gracefully closes the Redis connection: it stops accepting new commands, which then fail with
+// shutdown.ErrShuttingDown, waits for the in-flight ones to complete and closes the client.
+// The client is closed even if ctx is done before the in-flight commands complete, in which case the context's error is returned.
+func (inst *Service) Shutdown(ctx context.Context) error {
+	drainErr := inst.inflight.Drain(ctx)
+	if err := inst.Close(); err != nil {
+		return err
+	}
+
+	return drainErr
+}
@@ -0,0 +1,227 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Z represents a member of a Redis sorted set and its score.
+type Z struct {
+	// Member is the member name within the sorted set.
+	Member string
+
+	// Score is the score by which the member is ordered.
+	Score float64
+}
+
+// ZPopMin removes and returns up to count members with the lowest scores from a Redis sorted set, lowest first.
+// It uses the stored timeout in the Service struct and returns the popped members with their scores,
+// or an error if the operation fails.
+func (inst *Service) ZPopMin(key string, count int64) ([]Z, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZPopMin(ctx, key, count).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrZPopMin, key, err)
+	}
+
+	return toZ(result), nil
+}
+
+// ZPopMax removes and returns up to count members with the highest scores from a Redis sorted set, highest first.
+// It uses the stored timeout in the Service struct and returns the popped members with their scores,
+// or an error if the operation fails.
+func (inst *Service) ZPopMax(key string, count int64) ([]Z, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZPopMax(ctx, key, count).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrZPopMax, key, err)
+	}
+
+	return toZ(result), nil
+}
+
+// BZPopMin removes and returns the member with the lowest score from the first non-empty sorted set among the given keys,
+// blocking up to the given timeout until a member is available. A timeout of 0 blocks indefinitely.
+// It returns the name of the sorted set and the popped member, or an error if the timeout expires or the operation fails.
+func (inst *Service) BZPopMin(timeout time.Duration, keys ...string) (string, Z, error) {
+	ctx, cancel := inst.getBlockingTimeout(timeout)
+	defer cancel()
+
+	result, err := inst.getClient().BZPopMin(ctx, timeout, keys...).Result()
+	if err != nil {
+		return "", Z{}, fmt.Errorf(ErrBZPopMin, keys, err)
+	}
+
+	return result.Key, toZ([]redis.Z{result.Z})[0], nil
+}
+
+// BZPopMax removes and returns the member with the highest score from the first non-empty sorted set among the given keys,
+// blocking up to the given timeout until a member is available. A timeout of 0 blocks indefinitely.
+// It returns the name of the sorted set and the popped member, or an error if the timeout expires or the operation fails.
+func (inst *Service) BZPopMax(timeout time.Duration, keys ...string) (string, Z, error) {
+	ctx, cancel := inst.getBlockingTimeout(timeout)
+	defer cancel()
+
+	result, err := inst.getClient().BZPopMax(ctx, timeout, keys...).Result()
+	if err != nil {
+		return "", Z{}, fmt.Errorf(ErrBZPopMax, keys, err)
+	}
+
+	return result.Key, toZ([]redis.Z{result.Z})[0], nil
+}
+
+// ZMScore retrieves the scores of multiple members of a Redis sorted set in a single round trip.
+// It uses the stored timeout in the Service struct and returns the scores in the order of the given members,
+// with a nil entry for each member that does not exist, or an error if the operation fails.
+func (inst *Service) ZMScore(key string, members ...string) ([]*float64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// Send the command directly, as the go-redis helper reports missing members as zero scores.
+	args := make([]interface{}, 0, len(members)+2)
+	args = append(args, "zmscore", key)
+	for _, member := range members {
+		args = append(args, member)
+	}
+	result, err := inst.getClient().Do(ctx, args...).Slice()
+	if err != nil {
+		return nil, fmt.Errorf(ErrZMScore, members, key, err)
+	}
+
+	// Missing members are returned as nil, and scores as strings or floats depending on the protocol version.
+	scores := make([]*float64, len(result))
+	for i, value := range result {
+		if value == nil {
+			continue
+		}
+		score, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf(ErrZMScore, members, key, err)
+		}
+		scores[i] = &score
+	}
+
+	return scores, nil
+}
+
+// ZRevRange retrieves the members of a Redis sorted set between the start and stop ranks, ordered from the highest
+// to the lowest score, e.g. ZRevRange(key, 0, 9) for the top 10 of a leaderboard. Ranks are inclusive and 0-based,
+// with negative values counting from the end.
+// It uses the stored timeout in the Service struct and returns the members with their scores, or an error if the operation fails.
+func (inst *Service) ZRevRange(key string, start, stop int64) ([]Z, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZRangeArgsWithScores(ctx, redis.ZRangeArgs{Key: key, Start: start, Stop: stop, Rev: true}).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrZRevRange, start, stop, key, err)
+	}
+
+	return toZ(result), nil
+}
+
+// ZRevRangeByScore retrieves the members of a Redis sorted set with scores between max and min, ordered from the highest
+// to the lowest score. The bounds follow the Redis syntax: inclusive by default, exclusive when prefixed with "(",
+// and "+inf" or "-inf" for no bound. The offset and count paginate the results: a count of 0 returns no members,
+// and a negative count returns all members after the offset.
+// It uses the stored timeout in the Service struct and returns the members with their scores, or an error if the operation fails.
+func (inst *Service) ZRevRangeByScore(key, max, min string, offset, count int64) ([]Z, error) {
+	if count == 0 {
+		return []Z{}, nil
+	}
+
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	// The arguments expect the lower bound as Start and the upper bound as Stop, and swap them for reverse ranges.
+	args := redis.ZRangeArgs{Key: key, Start: min, Stop: max, ByScore: true, Rev: true, Offset: offset, Count: count}
+	result, err := inst.getClient().ZRangeArgsWithScores(ctx, args).Result()
+	if err != nil {
+		return nil, fmt.Errorf(ErrZRevRangeByScore, key, max, min, err)
+	}
+
+	return toZ(result), nil
+}
+
+// ZUnionStore stores the union of the given sorted sets in the destination key, overwriting it if it exists.
+// Each source key's scores are multiplied by the matching weight, if weights are provided, and the scores of a member
+// present in several keys are combined with the aggregate function (ZAggregateSum, ZAggregateMin or ZAggregateMax).
+// An empty aggregate defaults to ZAggregateSum.
+// It uses the stored timeout in the Service struct and returns the number of members in the destination key,
+// or an error if the operation fails.
+func (inst *Service) ZUnionStore(dest string, keys []string, weights []float64, aggregate string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZUnionStore(ctx, dest, &redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate}).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrZUnionStore, keys, dest, err)
+	}
+
+	return result, nil
+}
+
+// ZInterStore stores the intersection of the given sorted sets in the destination key, overwriting it if it exists.
+// Weights and aggregate are applied as in ZUnionStore.
+// It uses the stored timeout in the Service struct and returns the number of members in the destination key,
+// or an error if the operation fails.
+func (inst *Service) ZInterStore(dest string, keys []string, weights []float64, aggregate string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZInterStore(ctx, dest, &redis.ZStore{Keys: keys, Weights: weights, Aggregate: aggregate}).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrZInterStore, keys, dest, err)
+	}
+
+	return result, nil
+}
+
+// ZDiffStore stores the members of the first sorted set that are not in any of the other sorted sets in the destination key,
+// overwriting it if it exists.
+// It uses the stored timeout in the Service struct and returns the number of members in the destination key,
+// or an error if the operation fails.
+func (inst *Service) ZDiffStore(dest string, keys ...string) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZDiffStore(ctx, dest, keys...).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrZDiffStore, keys, dest, err)
+	}
+
+	return result, nil
+}
+
+// ZRangeStore stores the members of a sorted set between the start and stop ranks (inclusive, 0-based, negative values
+// counting from the end) in the destination key, overwriting it if it exists.
+// It uses the stored timeout in the Service struct and returns the number of members in the destination key,
+// or an error if the operation fails.
+func (inst *Service) ZRangeStore(dest, key string, start, stop int64) (int64, error) {
+	ctx, cancel := inst.getTimeout()
+	defer cancel()
+
+	result, err := inst.getClient().ZRangeStore(ctx, dest, redis.ZRangeArgs{Key: key, Start: start, Stop: stop}).Result()
+	if err != nil {
+		return 0, fmt.Errorf(ErrZRangeStore, start, stop, key, dest, err)
+	}
+
+	return result, nil
+}
+
+// toZ is a helper function to convert go-redis sorted set members to Z values.
+func toZ(members []redis.Z) []Z {
+	result := make([]Z, len(members))
+	for i, member := range members {
+		result[i] = Z{Member: fmt.Sprint(member.Member), Score: member.Score}
+	}
+
+	return result
+}
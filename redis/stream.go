@@ -20,7 +20,7 @@ func (inst *Service) AddToStream(stream string, values map[string]interface{}, i
 		streamID = id[0]
 	}
 
-	result, err := inst.client.XAdd(ctx, &redis.XAddArgs{
+	result, err := inst.getClient().XAdd(ctx, &redis.XAddArgs{
 		Stream: stream,
 		ID:     streamID,
 		Values: values,
@@ -37,14 +37,14 @@ func (inst *Service) AddToStream(stream string, values map[string]interface{}, i
 // It uses XRead and supports blocking. The `lastID` defaults to DefaultLastID if not provided.
 // Returns the read messages or an error if the operation fails.
 func (inst *Service) ReadFromStream(stream string, count int64, block time.Duration, lastID string) ([]redis.XMessage, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), block+time.Duration(inst.timeout)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), block+inst.timeout)
 	defer cancel()
 
 	if lastID == "" {
 		lastID = DefaultLastID
 	}
 
-	result, err := inst.client.XRead(ctx, &redis.XReadArgs{
+	result, err := inst.getClient().XRead(ctx, &redis.XReadArgs{
 		Streams: []string{stream, lastID},
 		Count:   count,
 		Block:   block,
@@ -66,14 +66,14 @@ func (inst *Service) ReadFromStream(stream string, count int64, block time.Durat
 // It uses XReadGroup and supports blocking. The `lastID` defaults to DefaultGroupLastID if not provided.
 // Optionally, messages can be auto-acknowledged after reading. Returns the read messages or an error if the operation fails.
 func (inst *Service) ReadGroupFromStream(stream, group, consumer string, count int64, block time.Duration, lastID string, autoAck bool) ([]redis.XMessage, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), block+time.Duration(inst.timeout)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), block+inst.timeout)
 	defer cancel()
 
 	if lastID == "" {
 		lastID = DefaultGroupLastID
 	}
 
-	result, err := inst.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+	result, err := inst.getClient().XReadGroup(ctx, &redis.XReadGroupArgs{
 		Group:    group,
 		Consumer: consumer,
 		Streams:  []string{stream, lastID},
@@ -108,7 +108,7 @@ func (inst *Service) AcknowledgeMessage(stream, group, id string) (int64, error)
 	ctx, cancel := inst.getTimeout()
 	defer cancel()
 
-	result, err := inst.client.XAck(ctx, stream, group, id).Result()
+	result, err := inst.getClient().XAck(ctx, stream, group, id).Result()
 	if err != nil {
 		return 0, fmt.Errorf(ErrAcknowledgeMessage, err)
 	}
@@ -126,7 +126,7 @@ func (inst *Service) CreateConsumerGroup(stream, group, startID string) error {
 		startID = DefaultStartID
 	}
 
-	err := inst.client.XGroupCreateMkStream(ctx, stream, group, startID).Err()
+	err := inst.getClient().XGroupCreateMkStream(ctx, stream, group, startID).Err()
 	if err != nil {
 		return fmt.Errorf(ErrCreateConsumerGroup, err)
 	}
@@ -145,7 +145,7 @@ func (inst *Service) ClaimPendingMessages(stream, group, consumer string, minIdl
 		count = DefaultClaimCount
 	}
 
-	result, start, err := inst.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+	result, start, err := inst.getClient().XAutoClaim(ctx, &redis.XAutoClaimArgs{
 		Stream:   stream,
 		Group:    group,
 		Consumer: consumer,
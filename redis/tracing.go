@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"context"
+	"net"
+
+	"github.com/nguyendang2000/shared-go/tracing"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingHook is a go-redis hook tracing each command in its own span. The Service methods do not take a context,
+// so the spans are roots and do not join the caller's trace.
+type tracingHook struct {
+	tracer trace.Tracer
+}
+
+// DialHook passes dialing through unchanged.
+func (inst tracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook traces a single command in a span named after the command (e.g. "get", "hset").
+// A missing key (redis.Nil) is not recorded as an error.
+func (inst tracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := tracing.Start(ctx, inst.tracer, tracing.SystemRedis, cmd.FullName())
+		err := next(ctx, cmd)
+		tracing.End(span, observedError(err))
+		return err
+	}
+}
+
+// ProcessPipelineHook traces a pipeline or transaction in a single "pipeline" span.
+func (inst tracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := tracing.Start(ctx, inst.tracer, tracing.SystemRedis, "pipeline")
+		err := next(ctx, cmds)
+		tracing.End(span, observedError(err))
+		return err
+	}
+}
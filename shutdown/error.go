@@ -0,0 +1,6 @@
+package shutdown
+
+import "errors"
+
+// ErrShuttingDown is returned for operations started after a Service began shutting down.
+var ErrShuttingDown = errors.New("service is shutting down")
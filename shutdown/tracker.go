@@ -0,0 +1,68 @@
+package shutdown
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracker tracks in-flight operations so a Service can stop accepting new ones and wait for the remaining ones
+// to complete before closing its connections. The zero value is ready to use and it is safe for concurrent use.
+type Tracker struct {
+	mu       sync.RWMutex
+	closing  bool
+	inflight sync.WaitGroup
+}
+
+// Begin registers a new in-flight operation, which must be completed by calling End.
+// It returns ErrShuttingDown without registering the operation once Stop or Drain has been called.
+func (inst *Tracker) Begin() error {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
+
+	if inst.closing {
+		return ErrShuttingDown
+	}
+	inst.inflight.Add(1)
+
+	return nil
+}
+
+// End completes an operation registered with Begin.
+func (inst *Tracker) End() {
+	inst.inflight.Done()
+}
+
+// Stop stops accepting new operations, which then fail with ErrShuttingDown, without waiting for the in-flight ones.
+func (inst *Tracker) Stop() {
+	// Taking the write lock guarantees that no Begin call is adding to the WaitGroup once it returns
+	inst.mu.Lock()
+	inst.closing = true
+	inst.mu.Unlock()
+}
+
+// Closing reports whether Stop or Drain has been called.
+func (inst *Tracker) Closing() bool {
+	inst.mu.RLock()
+	defer inst.mu.RUnlock()
+
+	return inst.closing
+}
+
+// Drain stops accepting new operations and waits for the in-flight ones to complete.
+// It returns the context's error if ctx is done before all operations complete.
+func (inst *Tracker) Drain(ctx context.Context) error {
+	inst.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		inst.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
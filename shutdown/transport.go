@@ -0,0 +1,56 @@
+package shutdown
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// transport is an http.RoundTripper registering each request as an in-flight operation of a Tracker.
+type transport struct {
+	next    http.RoundTripper
+	tracker *Tracker
+}
+
+// NewTransport wraps the given http.RoundTripper so that each request is tracked by the tracker,
+// from the moment it is sent until its response body is closed. Requests sent once the tracker
+// is draining fail with ErrShuttingDown.
+func NewTransport(next http.RoundTripper, tracker *Tracker) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &transport{next: next, tracker: tracker}
+}
+
+// RoundTrip executes the request with the wrapped RoundTripper while it is tracked.
+func (inst *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := inst.tracker.Begin(); err != nil {
+		return nil, err
+	}
+
+	res, err := inst.next.RoundTrip(req)
+	if err != nil {
+		inst.tracker.End()
+		return nil, err
+	}
+
+	// Keep the request tracked until the caller is done reading the response
+	res.Body = &trackedBody{ReadCloser: res.Body, end: inst.tracker.End}
+
+	return res, nil
+}
+
+// trackedBody is a response body completing its tracked operation when closed.
+type trackedBody struct {
+	io.ReadCloser
+	once sync.Once
+	end  func()
+}
+
+// Close closes the response body and completes the tracked operation, once.
+func (inst *trackedBody) Close() error {
+	err := inst.ReadCloser.Close()
+	inst.once.Do(inst.end)
+	return err
+}
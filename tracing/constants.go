@@ -0,0 +1,25 @@
+package tracing
+
+// TracerName is the instrumentation name of the tracers created by this module.
+const TracerName = "github.com/nguyendang2000/shared-go"
+
+// Standard span attributes recorded on every operation.
+const (
+	// AttributeDBSystem identifies the backing service, e.g. "redis", "mongodb" or "minio".
+	AttributeDBSystem = "db.system"
+
+	// AttributeDBOperation identifies the operation, e.g. the command name.
+	AttributeDBOperation = "db.operation"
+)
+
+// Systems reported in the db.system attribute.
+const (
+	// SystemRedis identifies operations performed by the redis package.
+	SystemRedis = "redis"
+
+	// SystemMongo identifies operations performed by the mongo package.
+	SystemMongo = "mongodb"
+
+	// SystemMinio identifies operations performed by the minio package.
+	SystemMinio = "minio"
+)
@@ -0,0 +1,7 @@
+package tracing
+
+// Error messages for the tracing package.
+const (
+	// ErrUnexpectedStatus is recorded when an HTTP request completes with a server error status.
+	ErrUnexpectedStatus = "unexpected status code %d"
+)
@@ -0,0 +1,35 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Start starts a client span named after the operation from the given context, annotated with the standard
+// db.system and db.operation attributes. The returned span must be ended by the caller, usually through End.
+func Start(ctx context.Context, tracer trace.Tracer, system, operation string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String(AttributeDBSystem, system),
+			attribute.String(AttributeDBOperation, operation),
+		),
+	)
+}
+
+// End records the error, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Tracer returns the tracer of this module from the given provider.
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	return provider.Tracer(TracerName)
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// transport is an http.RoundTripper tracing each request as an operation named after its HTTP method.
+type transport struct {
+	system string
+	next   http.RoundTripper
+	tracer trace.Tracer
+}
+
+// NewTransport wraps the given http.RoundTripper so that each request is traced with a child span of the request context.
+// Requests failing at the transport level or with a 5xx status are recorded as errors.
+// If the provider is nil, the given RoundTripper is returned unchanged.
+func NewTransport(system string, next http.RoundTripper, provider trace.TracerProvider) http.RoundTripper {
+	if provider == nil {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &transport{system: system, next: next, tracer: Tracer(provider)}
+}
+
+// RoundTrip executes the request with the wrapped RoundTripper within a span.
+func (inst *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Start(req.Context(), inst.tracer, inst.system, req.Method)
+	res, err := inst.next.RoundTrip(req.WithContext(ctx))
+
+	observed := err
+	if observed == nil && res.StatusCode >= http.StatusInternalServerError {
+		observed = fmt.Errorf(ErrUnexpectedStatus, res.StatusCode)
+	}
+	End(span, observed)
+
+	return res, err
+}